@@ -0,0 +1,230 @@
+package graph
+
+import (
+	"strings"
+
+	"github.com/qsoulior/misc/set"
+)
+
+// Point represents coordinates of grid cell.
+type Point struct{ X, Y int }
+
+// Neighborhood represents set of cells adjacent to grid cell.
+type Neighborhood int
+
+const (
+	// FourNeighbors includes horizontal and vertical neighbors of cell.
+	FourNeighbors Neighborhood = iota
+	// EightNeighbors includes horizontal, vertical and diagonal neighbors of cell.
+	EightNeighbors
+)
+
+// Grid implements rectangular grid of cells.
+// Each cell is either free or blocked and has cost of entering it (1 by default).
+type Grid struct {
+	width        int
+	height       int
+	neighborhood Neighborhood
+	blocked      set.HashSet[Point]
+	costs        map[Point]int
+}
+
+// NewGrid returns new grid of width*height free cells with specified neighborhood.
+// Negative width or height is treated as 0.
+func NewGrid(width, height int, neighborhood Neighborhood) *Grid {
+	return &Grid{
+		width:        max(width, 0),
+		height:       max(height, 0),
+		neighborhood: neighborhood,
+		blocked:      make(set.HashSet[Point]),
+		costs:        make(map[Point]int),
+	}
+}
+
+// Width returns number of grid columns, O(1).
+func (g Grid) Width() int { return g.width }
+
+// Height returns number of grid rows, O(1).
+func (g Grid) Height() int { return g.height }
+
+// Contains returns true if point p lies within grid bounds, O(1).
+func (g Grid) Contains(p Point) bool { return p.X >= 0 && p.X < g.width && p.Y >= 0 && p.Y < g.height }
+
+// Block marks cell p as blocked, O(1).
+func (g *Grid) Block(p Point) { g.blocked.Add(p) }
+
+// Unblock marks cell p as free, O(1).
+func (g *Grid) Unblock(p Point) { g.blocked.Remove(p) }
+
+// Blocked returns true if cell p is blocked or lies outside grid bounds, O(1).
+func (g Grid) Blocked(p Point) bool { return !g.Contains(p) || g.blocked.Contains(p) }
+
+// SetCost sets cost of entering cell p, O(1).
+// Negative cost is clamped to 0, because Dijkstra's algorithm requires non-negative weights.
+func (g *Grid) SetCost(p Point, cost int) { g.costs[p] = max(cost, 0) }
+
+// Cost returns cost of entering cell p, O(1).
+func (g Grid) Cost(p Point) int {
+	if cost, ok := g.costs[p]; ok {
+		return cost
+	}
+	return 1
+}
+
+// Neighbors returns free cells adjacent to cell p, O(1).
+// Diagonal neighbor is adjacent only if both cells sharing its corner with p are free.
+func (g Grid) Neighbors(p Point) []Point {
+	neighbors := make([]Point, 0, 8)
+	for _, d := range []Point{{1, 0}, {0, 1}, {-1, 0}, {0, -1}} {
+		if n := (Point{p.X + d.X, p.Y + d.Y}); !g.Blocked(n) {
+			neighbors = append(neighbors, n)
+		}
+	}
+
+	if g.neighborhood == EightNeighbors {
+		for _, d := range []Point{{1, 1}, {-1, 1}, {-1, -1}, {1, -1}} {
+			n := Point{p.X + d.X, p.Y + d.Y}
+			if !g.Blocked(n) && !g.Blocked(Point{p.X + d.X, p.Y}) && !g.Blocked(Point{p.X, p.Y + d.Y}) {
+				neighbors = append(neighbors, n)
+			}
+		}
+	}
+
+	return neighbors
+}
+
+// Graph returns weighted graph of free cells, O(n) where n is number of cells.
+// Weight of edge is cost of entering its destination cell.
+func (g Grid) Graph() WeightedGraph[Point] {
+	graph := make(WeightedGraph[Point])
+	for y := range g.height {
+		for x := range g.width {
+			p := Point{x, y}
+			if g.Blocked(p) {
+				continue
+			}
+
+			graph[p] = make(map[Point]int)
+			for _, n := range g.Neighbors(p) {
+				graph[p][n] = g.Cost(n)
+			}
+		}
+	}
+
+	return graph
+}
+
+// Dijkstra searches for the cheapest path from start to target using QuickDijkstra,
+// complexity is O(n*log(n)) where n is number of cells.
+// It returns path including start and target and its cost.
+// If target is unreachable, it returns nil, 0 and false as third value.
+func (g Grid) Dijkstra(start, target Point) ([]Point, int, bool) {
+	if g.Blocked(target) {
+		return nil, 0, false
+	}
+
+	dists, parents := g.Graph().QuickDijkstra(start)
+	return g.path(dists, parents, start, target)
+}
+
+// AStar searches for the cheapest path from start to target using A* search,
+// complexity is O(n*log(n)) where n is number of cells.
+// Heuristic is Manhattan distance for four neighbors and Chebyshev distance for eight neighbors,
+// multiplied by minimum cell cost.
+// It returns path including start and target and its cost.
+// If target is unreachable, it returns nil, 0 and false as third value.
+func (g Grid) AStar(start, target Point) ([]Point, int, bool) {
+	if g.Blocked(target) {
+		return nil, 0, false
+	}
+
+	minCost := g.minCost()
+	heuristic := func(p Point) int {
+		dx, dy := abs(p.X-target.X), abs(p.Y-target.Y)
+		if g.neighborhood == EightNeighbors {
+			return minCost * max(dx, dy)
+		}
+		return minCost * (dx + dy)
+	}
+
+	dists, parents := g.Graph().AStar(start, target, heuristic)
+	return g.path(dists, parents, start, target)
+}
+
+// Render returns ASCII representation of grid with path drawn on it, O(n).
+// Free cells are drawn as '.', blocked cells as '#', path cells as '*',
+// the first and the last path cells as 'S' and 'T'.
+// Rows are separated by newline characters.
+func (g Grid) Render(path []Point) string {
+	cells := make([][]byte, g.height)
+	for y := range cells {
+		cells[y] = make([]byte, g.width)
+		for x := range cells[y] {
+			cells[y][x] = '.'
+			if g.Blocked(Point{x, y}) {
+				cells[y][x] = '#'
+			}
+		}
+	}
+
+	for i, p := range path {
+		if !g.Contains(p) {
+			continue
+		}
+
+		switch i {
+		case 0:
+			cells[p.Y][p.X] = 'S'
+		case len(path) - 1:
+			cells[p.Y][p.X] = 'T'
+		default:
+			cells[p.Y][p.X] = '*'
+		}
+	}
+
+	var b strings.Builder
+	for _, row := range cells {
+		b.Write(row)
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// path restores path from start to target using distance map and parent map.
+func (g Grid) path(dists map[Point]int, parents map[Point]Point, start, target Point) ([]Point, int, bool) {
+	dist, ok := dists[target]
+	if !ok {
+		return nil, 0, false
+	}
+
+	return Path(parents, start, target), dist, true
+}
+
+// minCost returns minimum cost of entering grid cell.
+func (g Grid) minCost() int {
+	minCost, custom := -1, 0
+	for p, cost := range g.costs {
+		if g.Contains(p) {
+			custom++
+			if minCost == -1 || cost < minCost {
+				minCost = cost
+			}
+		}
+	}
+
+	// If some cells have no custom cost, default cost is also used.
+	if custom < g.width*g.height && (minCost == -1 || minCost > 1) {
+		minCost = 1
+	}
+
+	return max(minCost, 0)
+}
+
+// abs returns absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,190 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+// simpleGrid returns grid with wall that has single gap at the bottom.
+//
+//	.#..
+//	.#..
+//	....
+func simpleGrid(neighborhood Neighborhood) *Grid {
+	g := NewGrid(4, 3, neighborhood)
+	g.Block(Point{1, 0})
+	g.Block(Point{1, 1})
+	return g
+}
+
+func TestGrid_Blocked(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Point
+		want bool
+	}{
+		{"FreeCell", Point{0, 0}, false},
+		{"BlockedCell", Point{1, 1}, true},
+		{"OutsideCell", Point{4, 0}, true},
+		{"NegativeCell", Point{-1, 0}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := simpleGrid(FourNeighbors).Blocked(tt.p); got != tt.want {
+				t.Errorf("Grid.Blocked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewGrid(t *testing.T) {
+	tests := []struct {
+		name   string
+		width  int
+		height int
+		want   int
+		want1  int
+		want2  string
+	}{
+		{"SimpleGrid", 2, 1, 2, 1, "..\n"},
+		{"NegativeWidth", -1, 2, 0, 2, "\n\n"},
+		{"NegativeHeight", 2, -1, 2, 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGrid(tt.width, tt.height, FourNeighbors)
+			if got := g.Width(); got != tt.want {
+				t.Errorf("Grid.Width() = %v, want %v", got, tt.want)
+			}
+			if got := g.Height(); got != tt.want1 {
+				t.Errorf("Grid.Height() = %v, want %v", got, tt.want1)
+			}
+			if got := g.Render(nil); got != tt.want2 {
+				t.Errorf("Grid.Render() = %q, want %q", got, tt.want2)
+			}
+		})
+	}
+}
+
+func TestGrid_SetCost(t *testing.T) {
+	tests := []struct {
+		name string
+		cost int
+		want int
+	}{
+		{"PositiveCost", 5, 5},
+		{"ZeroCost", 0, 0},
+		{"NegativeCost", -5, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGrid(3, 3, FourNeighbors)
+			g.SetCost(Point{1, 1}, tt.cost)
+			if got := g.Cost(Point{1, 1}); got != tt.want {
+				t.Errorf("Grid.Cost() = %v, want %v", got, tt.want)
+			}
+
+			// Search terminates, because there are no negative cycles.
+			// Path goes through center or around it.
+			wantCost := min(tt.want+1, 4)
+			if _, cost, ok := g.Dijkstra(Point{0, 1}, Point{2, 1}); !ok || cost != wantCost {
+				t.Errorf("Grid.Dijkstra() got1 = %v, got2 = %v, want %v and %v", cost, ok, wantCost, true)
+			}
+		})
+	}
+}
+
+func TestGrid_Neighbors(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *Grid
+		p    Point
+		want []Point
+	}{
+		{"FourNeighbors", simpleGrid(FourNeighbors), Point{2, 1}, []Point{{3, 1}, {2, 2}, {2, 0}}},
+		{"EightNeighbors", simpleGrid(EightNeighbors), Point{2, 1}, []Point{{3, 1}, {2, 2}, {2, 0}, {3, 2}, {3, 0}}},
+		{"CornerCutting", simpleGrid(EightNeighbors), Point{0, 2}, []Point{{1, 2}, {0, 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.Neighbors(tt.p); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Grid.Neighbors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrid_Graph(t *testing.T) {
+	g := NewGrid(2, 2, FourNeighbors)
+	g.Block(Point{1, 1})
+	g.SetCost(Point{0, 1}, 5)
+	want := WeightedGraph[Point]{
+		{0, 0}: {{1, 0}: 1, {0, 1}: 5},
+		{1, 0}: {{0, 0}: 1},
+		{0, 1}: {{0, 0}: 1},
+	}
+
+	if got := g.Graph(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Grid.Graph() = %v, want %v", got, want)
+	}
+}
+
+func testGridPath(t *testing.T, fn func(g *Grid, start, target Point) ([]Point, int, bool)) {
+	expensive := simpleGrid(FourNeighbors)
+	expensive.SetCost(Point{1, 2}, 10)
+
+	type args struct {
+		start  Point
+		target Point
+	}
+	tests := []struct {
+		name  string
+		g     *Grid
+		args  args
+		want  []Point
+		want1 int
+		want2 bool
+	}{
+		{"SameCell", simpleGrid(FourNeighbors), args{Point{0, 0}, Point{0, 0}}, []Point{{0, 0}}, 0, true},
+		{"BlockedTarget", simpleGrid(FourNeighbors), args{Point{0, 0}, Point{1, 0}}, nil, 0, false},
+		{"BlockedStart", simpleGrid(FourNeighbors), args{Point{1, 0}, Point{0, 0}}, nil, 0, false},
+		{"FourNeighbors", simpleGrid(FourNeighbors), args{Point{0, 0}, Point{2, 0}},
+			[]Point{{0, 0}, {0, 1}, {0, 2}, {1, 2}, {2, 2}, {2, 1}, {2, 0}}, 6, true},
+		{"EightNeighbors", NewGrid(3, 3, EightNeighbors), args{Point{0, 0}, Point{2, 2}},
+			[]Point{{0, 0}, {1, 1}, {2, 2}}, 2, true},
+		{"CellCosts", expensive, args{Point{0, 0}, Point{2, 0}},
+			[]Point{{0, 0}, {0, 1}, {0, 2}, {1, 2}, {2, 2}, {2, 1}, {2, 0}}, 15, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2 := fn(tt.g, tt.args.start, tt.args.target)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("got1 = %v, want %v", got1, tt.want1)
+			}
+			if got2 != tt.want2 {
+				t.Errorf("got2 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+}
+
+func TestGrid_Dijkstra(t *testing.T) {
+	testGridPath(t, (*Grid).Dijkstra)
+}
+
+func TestGrid_AStar(t *testing.T) {
+	testGridPath(t, (*Grid).AStar)
+}
+
+func TestGrid_Render(t *testing.T) {
+	g := simpleGrid(FourNeighbors)
+	path := []Point{{0, 0}, {0, 1}, {0, 2}, {1, 2}, {2, 2}, {2, 1}, {2, 0}}
+	want := "S#T.\n*#*.\n***.\n"
+
+	if got := g.Render(path); got != want {
+		t.Errorf("Grid.Render() = %q, want %q", got, want)
+	}
+}
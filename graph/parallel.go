@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/qsoulior/misc/queue"
+)
+
+// ParallelDFS represents parallel depth-first search with complexity O(n+m) of total work,
+// where n is number of vertices and m is number of edges. Work is distributed across workers,
+// but speedup depends on graph shape and is not guaranteed.
+// Each of workers goroutines traverses graph depth-first using its own work-stealing deque
+// and steals vertices from other workers when its deque is empty.
+// Workers that have nothing to steal are parked until vertices are pushed.
+// ParallelDFS starts from vertex start and uses cmp to compare each vertex with target,
+// cmp is called concurrently. If several vertices match target, any of them can be returned.
+// It returns found vertex or default value of type T and false as second value.
+func (g UnweightedGraph[T]) ParallelDFS(start T, workers int, cmp func(value T) bool) (T, bool) {
+	var result T
+	if _, ok := g[start]; !ok {
+		return result, false
+	}
+
+	deques := make([]queue.WorkStealingDeque[T], max(workers, 1))
+	for i := range deques {
+		deques[i] = queue.NewWorkStealingDeque[T]()
+	}
+
+	var (
+		enqueued sync.Map     // vertices that have been pushed into deques
+		pending  atomic.Int64 // number of pushed vertices that are not processed yet
+		found    atomic.Bool
+		once     sync.Once
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		idle     atomic.Int64 // number of parked workers
+	)
+	cond := sync.NewCond(&mu)
+
+	// wake wakes up parked workers.
+	wake := func() {
+		mu.Lock()
+		cond.Broadcast()
+		mu.Unlock()
+	}
+
+	// park blocks worker until vertices are pushed, target is found or all vertices are processed.
+	// It returns false if worker should stop.
+	park := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// Workers that push vertices check idle after pushing,
+		// so either they see parked worker or it sees their vertices.
+		idle.Add(1)
+		defer idle.Add(-1)
+		if found.Load() || pending.Load() == 0 {
+			return false
+		}
+		for _, deque := range deques {
+			if deque.Len() > 0 {
+				return true
+			}
+		}
+
+		cond.Wait()
+		return true
+	}
+
+	enqueued.Store(start, struct{}{})
+	pending.Add(1)
+	deques[0].PushBack(start)
+
+	for i, deque := range deques {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !found.Load() {
+				value, ok := deque.PopBack()
+				for j := 1; !ok && j < len(deques); j++ {
+					value, ok = deques[(i+j)%len(deques)].PopFront()
+				}
+
+				if !ok {
+					// Other workers may still push vertices, so wait for them.
+					if !park() {
+						return
+					}
+					continue
+				}
+
+				if cmp(value) {
+					once.Do(func() { result = value })
+					found.Store(true)
+					wake()
+					return
+				}
+
+				pushed := false
+				adjacents := g[value]
+				for k := len(adjacents) - 1; k >= 0; k-- {
+					if _, loaded := enqueued.LoadOrStore(adjacents[k], struct{}{}); !loaded {
+						pending.Add(1)
+						deque.PushBack(adjacents[k])
+						pushed = true
+					}
+				}
+				if pushed && idle.Load() > 0 {
+					wake()
+				}
+				if pending.Add(-1) == 0 {
+					wake()
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	return result, found.Load()
+}
@@ -0,0 +1,59 @@
+package graph
+
+import (
+	"math/rand"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+func TestUnweightedGraph_ParallelDFS(t *testing.T) {
+	type args struct {
+		start   string
+		workers int
+		cmp     func(value string) bool
+	}
+	tests := []struct {
+		name  string
+		g     UnweightedGraph[string]
+		args  args
+		want  []string
+		want1 bool
+	}{
+		{"EmptyGraph", emptyUnweightedGraph(), args{"you", 4, unweightedCmp}, []string{""}, false},
+		{"SingleWorker", simpleUnweightedGraph(), args{"you", 1, unweightedCmp}, []string{"jonny"}, true},
+		{"SimpleGraph", simpleUnweightedGraph(), args{"you", 4, unweightedCmp}, []string{"jane", "jonny"}, true},
+		{"IdleWorkers", simpleUnweightedGraph(), args{"you", 64, unweightedCmp}, []string{"jane", "jonny"}, true},
+		{"NotFound", simpleUnweightedGraph(), args{"bob", 4, unweightedCmp}, []string{""}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.g.ParallelDFS(tt.args.start, tt.args.workers, tt.args.cmp)
+			if !slices.Contains(tt.want, got) {
+				t.Errorf("UnweightedGraph.ParallelDFS() got = %v, want one of %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("UnweightedGraph.ParallelDFS() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestUnweightedGraph_ParallelDFS_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	g := ErdosRenyi(r, 2000, 0.002)
+
+	// Count reachable vertices by sequential BFS.
+	var want int64
+	g.BFS(0, func(int) bool { want++; return false })
+
+	for _, workers := range []int{1, 2, 8} {
+		var got atomic.Int64
+		if _, ok := g.ParallelDFS(0, workers, func(int) bool { got.Add(1); return false }); ok {
+			t.Errorf("UnweightedGraph.ParallelDFS() got1 = %v, want %v", ok, false)
+		}
+		if got.Load() != want {
+			t.Errorf("UnweightedGraph.ParallelDFS() visited %v vertices with %v workers, want %v", got.Load(), workers, want)
+		}
+	}
+}
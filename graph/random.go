@@ -0,0 +1,178 @@
+package graph
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+)
+
+// newIntGraph returns new unweighted graph with vertices from 0 to n-1 and no edges.
+func newIntGraph(n int) UnweightedGraph[int] {
+	graph := make(UnweightedGraph[int], n)
+	for i := range n {
+		graph[i] = []int{}
+	}
+
+	return graph
+}
+
+// ErdosRenyi returns new directed graph with vertices from 0 to n-1
+// generated by Erdős–Rényi model with complexity O(n^2).
+// Each of n*(n-1) possible edges is included with probability p.
+func ErdosRenyi(r *rand.Rand, n int, p float64) UnweightedGraph[int] {
+	graph := newIntGraph(n)
+	for i := range n {
+		for j := range n {
+			if i != j && r.Float64() < p {
+				graph[i] = append(graph[i], j)
+			}
+		}
+	}
+
+	return graph
+}
+
+// BarabasiAlbert returns new undirected graph with vertices from 0 to n-1
+// generated by Barabási–Albert preferential attachment model with complexity O(n*m^2).
+// Generation starts from complete graph of m+1 vertices, then each new vertex
+// is connected to m distinct existing vertices chosen with probability proportional to their degree.
+// Each undirected edge is represented as two directed edges.
+func BarabasiAlbert(r *rand.Rand, n, m int) UnweightedGraph[int] {
+	if m < 1 {
+		return newIntGraph(n)
+	}
+
+	if n <= m+1 {
+		return Complete(n)
+	}
+
+	graph := Complete(m + 1)
+
+	// Each vertex occurs in targets as many times as its degree.
+	targets := make([]int, 0, 2*n*m)
+	for i := range m + 1 {
+		for range m {
+			targets = append(targets, i)
+		}
+	}
+
+	for i := m + 1; i < n; i++ {
+		graph[i] = make([]int, 0, m)
+
+		// Choose m distinct vertices with probability proportional to their degree.
+		for len(graph[i]) < m {
+			if j := targets[r.Intn(len(targets))]; !slices.Contains(graph[i], j) {
+				graph[i] = append(graph[i], j)
+				graph[j] = append(graph[j], i)
+			}
+		}
+
+		for _, j := range graph[i] {
+			targets = append(targets, i, j)
+		}
+	}
+
+	return graph
+}
+
+// GridGraph returns new undirected graph of width*height vertices arranged in rectangular grid,
+// complexity is O(n) where n is number of vertices.
+// Vertex in column x and row y is y*width+x, it is connected to its horizontal and vertical neighbors.
+// Each undirected edge is represented as two directed edges.
+func GridGraph(width, height int) UnweightedGraph[int] {
+	if width <= 0 || height <= 0 {
+		return newIntGraph(0)
+	}
+
+	graph := newIntGraph(width * height)
+	for y := range height {
+		for x := range width {
+			i := y*width + x
+			if x > 0 {
+				graph[i] = append(graph[i], i-1)
+			}
+			if x < width-1 {
+				graph[i] = append(graph[i], i+1)
+			}
+			if y > 0 {
+				graph[i] = append(graph[i], i-width)
+			}
+			if y < height-1 {
+				graph[i] = append(graph[i], i+width)
+			}
+		}
+	}
+
+	return graph
+}
+
+// Complete returns new directed graph with vertices from 0 to n-1
+// in which every pair of distinct vertices is connected by edge, O(n^2).
+func Complete(n int) UnweightedGraph[int] {
+	graph := newIntGraph(n)
+	for i := range n {
+		graph[i] = make([]int, 0, n-1)
+		for j := range n {
+			if i != j {
+				graph[i] = append(graph[i], j)
+			}
+		}
+	}
+
+	return graph
+}
+
+// RandomTree returns new random tree with vertices from 0 to n-1 rooted at vertex 0, O(n).
+// Each vertex except root has exactly one incoming edge from its parent.
+func RandomTree(r *rand.Rand, n int) UnweightedGraph[int] {
+	graph := newIntGraph(n)
+	for i := 1; i < n; i++ {
+		parent := r.Intn(i)
+		graph[parent] = append(graph[parent], i)
+	}
+
+	return graph
+}
+
+// RandomDAG returns new random directed acyclic graph with vertices from 0 to n-1,
+// complexity is O(n^2).
+// Vertices are randomly ordered, then each edge from earlier to later vertex
+// is included with probability p. Negative n is treated as 0.
+func RandomDAG(r *rand.Rand, n int, p float64) UnweightedGraph[int] {
+	n = max(n, 0)
+	graph := newIntGraph(n)
+	order := r.Perm(n)
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			if r.Float64() < p {
+				graph[order[i]] = append(graph[order[i]], order[j])
+			}
+		}
+	}
+
+	return graph
+}
+
+// RandomWeights returns new weighted graph with vertices and edges of g,
+// complexity is O(n*log(n)+m).
+// Weight of each edge is chosen uniformly from range [0, maxWeight].
+// Negative maxWeight is treated as 0.
+// Vertices are visited in sorted order, so the same r produces the same weights.
+func RandomWeights[T cmp.Ordered](r *rand.Rand, g UnweightedGraph[T], maxWeight int) WeightedGraph[T] {
+	values := make([]T, 0, len(g))
+	for value := range g {
+		values = append(values, value)
+	}
+	slices.Sort(values)
+
+	graph := make(WeightedGraph[T], len(g))
+	for _, value := range values {
+		adjacents := g[value]
+		graph[value] = make(map[T]int, len(adjacents))
+		for _, adjacent := range adjacents {
+			graph[value][adjacent] = r.Intn(max(maxWeight, 0) + 1)
+		}
+	}
+
+	return graph
+}
@@ -0,0 +1,352 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/qsoulior/misc/queue"
+)
+
+// countEdges returns number of edges in g.
+func countEdges[T comparable](g UnweightedGraph[T]) int {
+	n := 0
+	for _, adjacents := range g {
+		n += len(adjacents)
+	}
+	return n
+}
+
+// isUndirected returns true if each edge of g has reversed edge.
+func isUndirected(g UnweightedGraph[int]) bool {
+	return reflect.DeepEqual(sortIntGraph(g.Reverse()), sortIntGraph(g))
+}
+
+// sortIntGraph sorts adjacent vertices of g and returns it.
+func sortIntGraph(g UnweightedGraph[int]) UnweightedGraph[int] {
+	for _, nodes := range g {
+		slices.Sort(nodes)
+	}
+	return g
+}
+
+// isAcyclic returns true if g has no cycles.
+func isAcyclic[T comparable](g UnweightedGraph[T]) bool {
+	// Kahn's algorithm removes vertices without incoming edges.
+	indegrees := make(map[T]int, len(g))
+	for _, adjacents := range g {
+		for _, adjacent := range adjacents {
+			indegrees[adjacent]++
+		}
+	}
+
+	var stack []T
+	for value := range g {
+		if indegrees[value] == 0 {
+			stack = append(stack, value)
+		}
+	}
+
+	removed := 0
+	for len(stack) > 0 {
+		value := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		removed++
+		for _, adjacent := range g[value] {
+			if indegrees[adjacent]--; indegrees[adjacent] == 0 {
+				stack = append(stack, adjacent)
+			}
+		}
+	}
+
+	return removed == len(g)
+}
+
+func TestErdosRenyi(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name  string
+		n     int
+		p     float64
+		edges int
+	}{
+		{"EmptyGraph", 0, 0.5, 0},
+		{"NoEdges", 10, 0, 0},
+		{"AllEdges", 10, 1, 90},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ErdosRenyi(r, tt.n, tt.p)
+			if len(got) != tt.n {
+				t.Errorf("len(ErdosRenyi()) = %v, want %v", len(got), tt.n)
+			}
+			if edges := countEdges(got); edges != tt.edges {
+				t.Errorf("ErdosRenyi() has %v edges, want %v", edges, tt.edges)
+			}
+		})
+	}
+}
+
+func TestBarabasiAlbert(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name  string
+		n     int
+		m     int
+		edges int
+	}{
+		{"NoEdges", 10, 0, 0},
+		{"SmallGraph", 3, 3, 6},
+		{"SimpleGraph", 100, 3, 2 * (6 + 96*3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BarabasiAlbert(r, tt.n, tt.m)
+			if len(got) != tt.n {
+				t.Errorf("len(BarabasiAlbert()) = %v, want %v", len(got), tt.n)
+			}
+			if edges := countEdges(got); edges != tt.edges {
+				t.Errorf("BarabasiAlbert() has %v edges, want %v", edges, tt.edges)
+			}
+			if !isUndirected(got) {
+				t.Error("BarabasiAlbert() is not undirected")
+			}
+		})
+	}
+}
+
+func TestGridGraph(t *testing.T) {
+	want := UnweightedGraph[int]{
+		0: []int{1, 3},
+		1: []int{0, 2, 4},
+		2: []int{1, 5},
+		3: []int{4, 0},
+		4: []int{3, 5, 1},
+		5: []int{4, 2},
+	}
+
+	tests := []struct {
+		name   string
+		width  int
+		height int
+		want   UnweightedGraph[int]
+	}{
+		{"EmptyGraph", 0, 3, UnweightedGraph[int]{}},
+		{"SimpleGraph", 3, 2, want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GridGraph(tt.width, tt.height); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GridGraph() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want UnweightedGraph[int]
+	}{
+		{"EmptyGraph", 0, UnweightedGraph[int]{}},
+		{"SingleVertex", 1, UnweightedGraph[int]{0: []int{}}},
+		{"SimpleGraph", 3, UnweightedGraph[int]{0: []int{1, 2}, 1: []int{0, 2}, 2: []int{0, 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Complete(tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Complete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRandomTree(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 10, 100} {
+		got := RandomTree(r, n)
+		if len(got) != n {
+			t.Errorf("len(RandomTree()) = %v, want %v", len(got), n)
+		}
+
+		// Every vertex is reachable from root.
+		visited := 0
+		got.BFS(0, func(value int) bool {
+			visited++
+			return false
+		})
+		if visited != n {
+			t.Errorf("RandomTree() has %v reachable vertices, want %v", visited, n)
+		}
+		if edges := countEdges(got); n > 0 && edges != n-1 {
+			t.Errorf("RandomTree() has %v edges, want %v", edges, n-1)
+		}
+	}
+}
+
+func TestRandomDAG(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name string
+		n    int
+		p    float64
+		want int
+	}{
+		{"NegativeVertices", -1, 0.5, 0},
+		{"NoEdges", 50, 0, 50},
+		{"SparseGraph", 50, 0.1, 50},
+		{"DenseGraph", 50, 0.5, 50},
+		{"CompleteGraph", 50, 1, 50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RandomDAG(r, tt.n, tt.p)
+			if len(got) != tt.want {
+				t.Errorf("len(RandomDAG()) = %v, want %v", len(got), tt.want)
+			}
+			if !isAcyclic(got) {
+				t.Errorf("RandomDAG() with p = %v has cycle", tt.p)
+			}
+		})
+	}
+}
+
+func TestRandomWeights(t *testing.T) {
+	g := ErdosRenyi(rand.New(rand.NewSource(1)), 30, 0.3)
+	tests := []struct {
+		name      string
+		maxWeight int
+		want      int
+	}{
+		{"NegativeWeight", -1, 0},
+		{"ZeroWeight", 0, 0},
+		{"SimpleWeight", 10, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RandomWeights(rand.New(rand.NewSource(2)), g, tt.maxWeight)
+			if !reflect.DeepEqual(got, RandomWeights(rand.New(rand.NewSource(2)), g, tt.maxWeight)) {
+				t.Error("RandomWeights() is not deterministic")
+			}
+
+			for value, adjacents := range got {
+				if len(adjacents) != len(g[value]) {
+					t.Errorf("RandomWeights()[%v] has %v edges, want %v", value, len(adjacents), len(g[value]))
+				}
+				for _, weight := range adjacents {
+					if weight < 0 || weight > tt.want {
+						t.Errorf("RandomWeights() weight = %v, want in range [0, %v]", weight, tt.want)
+					}
+				}
+			}
+		})
+	}
+}
+
+// randomGraphs returns weighted graphs produced by all generators with seed.
+func randomGraphs(seed int64) map[string]WeightedGraph[int] {
+	r := rand.New(rand.NewSource(seed))
+	n := 2 + r.Intn(60)
+	return map[string]WeightedGraph[int]{
+		"ErdosRenyi":     RandomWeights(r, ErdosRenyi(r, n, r.Float64()/4), 100),
+		"BarabasiAlbert": RandomWeights(r, BarabasiAlbert(r, n, 1+r.Intn(3)), 100),
+		"GridGraph":      RandomWeights(r, GridGraph(1+r.Intn(8), 1+r.Intn(8)), 100),
+		"Complete":       RandomWeights(r, Complete(n/4), 100),
+		"RandomTree":     RandomWeights(r, RandomTree(r, n), 100),
+		"RandomDAG":      RandomWeights(r, RandomDAG(r, n, r.Float64()/2), 100),
+	}
+}
+
+func TestWeightedGraph_DijkstraProperty(t *testing.T) {
+	for seed := range int64(100) {
+		for name, g := range randomGraphs(seed) {
+			got, _ := g.Dijkstra(0)
+			want, _ := g.QuickDijkstra(0)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%v(seed = %v): Dijkstra() = %v, QuickDijkstra() = %v", name, seed, got, want)
+			}
+		}
+	}
+}
+
+// indexedQueues contains constructors of indexed min priority queues for indexedDijkstra.
+var indexedQueues = []struct {
+	name string
+	new  func() queue.IndexedPriorityQueue[int]
+}{
+	{"Binary", queue.NewIndexedMinPriorityQueue[int]},
+	{"Pairing", queue.NewIndexedMinPairingPriorityQueue[int]},
+	{"Fibonacci", queue.NewIndexedMinFibonacciPriorityQueue[int]},
+}
+
+func TestWeightedGraph_IndexedDijkstraProperty(t *testing.T) {
+	for seed := range int64(100) {
+		for name, g := range randomGraphs(seed) {
+			want, _ := g.Dijkstra(0)
+			for _, q := range indexedQueues {
+				if got, _ := g.indexedDijkstra(0, q.new()); !reflect.DeepEqual(got, want) {
+					t.Errorf("%v(seed = %v): indexedDijkstra(%v) = %v, Dijkstra() = %v", name, seed, q.name, got, want)
+				}
+			}
+		}
+	}
+}
+
+func FuzzWeightedGraph_Dijkstra(f *testing.F) {
+	for seed := range int64(10) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		for name, g := range randomGraphs(seed) {
+			got, _ := g.Dijkstra(0)
+			want, _ := g.QuickDijkstra(0)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%v: Dijkstra() = %v, QuickDijkstra() = %v", name, got, want)
+			}
+		}
+	})
+}
+
+func benchmarkQuickDijkstra(b *testing.B, newQueue func() queue.PriorityQueue[int]) {
+	r := rand.New(rand.NewSource(1))
+	g := RandomWeights(r, ErdosRenyi(r, 2000, 0.01), 1000)
+	b.ResetTimer()
+	for range b.N {
+		g.quickDijkstra(0, newQueue())
+	}
+}
+
+func BenchmarkQuickDijkstra(b *testing.B) {
+	benchmarkQuickDijkstra(b, func() queue.PriorityQueue[int] { return queue.NewMinPriorityQueue[int]() })
+}
+
+func BenchmarkQuickDijkstra_Dary(b *testing.B) {
+	for _, d := range []int{4, 8} {
+		b.Run(strconv.Itoa(d), func(b *testing.B) {
+			benchmarkQuickDijkstra(b, func() queue.PriorityQueue[int] { return queue.NewMinDaryPriorityQueue[int](d) })
+		})
+	}
+}
+
+func BenchmarkQuickDijkstra_Pairing(b *testing.B) {
+	benchmarkQuickDijkstra(b, func() queue.PriorityQueue[int] { return queue.NewMinPairingPriorityQueue[int]() })
+}
+
+func BenchmarkQuickDijkstra_Fibonacci(b *testing.B) {
+	benchmarkQuickDijkstra(b, func() queue.PriorityQueue[int] { return queue.NewMinFibonacciPriorityQueue[int]() })
+}
+
+func BenchmarkIndexedDijkstra(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	g := RandomWeights(r, ErdosRenyi(r, 2000, 0.01), 1000)
+	for _, q := range indexedQueues {
+		b.Run(q.name, func(b *testing.B) {
+			for range b.N {
+				g.indexedDijkstra(0, q.new())
+			}
+		})
+	}
+}
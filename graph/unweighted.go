@@ -1,5 +1,6 @@
 // Package graph implements graph data structures and algorithms.
-// It provides unweighted and weighted graph implementations, BFS, DFS and Dijkstra's algorithm.
+// It provides unweighted and weighted graph implementations, BFS, DFS, parallel DFS, Dijkstra's algorithm,
+// A* search, grid pathfinding and graph transformations such as reverse, subgraph, union and intersection.
 package graph
 
 import (
@@ -72,3 +73,118 @@ func (g UnweightedGraph[T]) DFS(start T, cmp func(value T) bool) (T, bool) {
 	var value T
 	return value, false
 }
+
+// Reverse returns new graph with all edges of g reversed, O(n+m).
+// Every vertex of g is contained in returned graph.
+func (g UnweightedGraph[T]) Reverse() UnweightedGraph[T] {
+	graph := make(UnweightedGraph[T], len(g))
+	for value := range g {
+		graph[value] = []T{}
+	}
+
+	for value, adjacents := range g {
+		for _, adjacent := range adjacents {
+			graph[adjacent] = append(graph[adjacent], value)
+		}
+	}
+
+	return graph
+}
+
+// InducedSubgraph returns new graph with vertices of g contained in nodes
+// and all edges of g between them, O(n+m).
+func (g UnweightedGraph[T]) InducedSubgraph(nodes set.HashSet[T]) UnweightedGraph[T] {
+	graph := make(UnweightedGraph[T])
+	for value, adjacents := range g {
+		if !nodes.Contains(value) {
+			continue
+		}
+
+		graph[value] = make([]T, 0, len(adjacents))
+		for _, adjacent := range adjacents {
+			if nodes.Contains(adjacent) {
+				graph[value] = append(graph[value], adjacent)
+			}
+		}
+	}
+
+	return graph
+}
+
+// EdgeFilter returns new graph with all vertices of g
+// and edges for which pred returns true, O(n+m).
+func (g UnweightedGraph[T]) EdgeFilter(pred func(from, to T) bool) UnweightedGraph[T] {
+	graph := make(UnweightedGraph[T], len(g))
+	for value, adjacents := range g {
+		graph[value] = make([]T, 0, len(adjacents))
+		for _, adjacent := range adjacents {
+			if pred(value, adjacent) {
+				graph[value] = append(graph[value], adjacent)
+			}
+		}
+	}
+
+	return graph
+}
+
+// Union returns new graph with vertices and edges from both g and h,
+// complexity is O(n+m) where n and m are total numbers of vertices and edges.
+// Adjacent vertices from g precede adjacent vertices from h.
+func (g UnweightedGraph[T]) Union(h UnweightedGraph[T]) UnweightedGraph[T] {
+	graph := make(UnweightedGraph[T], len(g))
+	for _, src := range []UnweightedGraph[T]{g, h} {
+		for value, adjacents := range src {
+			graph[value] = appendUnique(graph[value], adjacents)
+		}
+	}
+
+	return graph
+}
+
+// Intersection returns new graph with vertices and edges common to g and h,
+// complexity is O(n+m) where n and m are total numbers of vertices and edges.
+func (g UnweightedGraph[T]) Intersection(h UnweightedGraph[T]) UnweightedGraph[T] {
+	graph := make(UnweightedGraph[T])
+	for value, adjacents := range g {
+		others, ok := h[value]
+		if !ok {
+			continue
+		}
+
+		common := make(set.HashSet[T], len(others))
+		for _, adjacent := range others {
+			common.Add(adjacent)
+		}
+
+		graph[value] = make([]T, 0)
+		for _, adjacent := range appendUnique(nil, adjacents) {
+			if common.Contains(adjacent) {
+				graph[value] = append(graph[value], adjacent)
+			}
+		}
+	}
+
+	return graph
+}
+
+// appendUnique appends values to dst that are not contained in it and returns the extended slice.
+// Returned slice is never nil and does not share memory with values.
+func appendUnique[T comparable](dst []T, values []T) []T {
+	if dst == nil {
+		dst = make([]T, 0, len(values))
+	}
+
+	contained := make(set.HashSet[T], len(dst)+len(values))
+	for _, value := range dst {
+		contained.Add(value)
+	}
+
+	for _, value := range values {
+		if !contained.Contains(value) {
+			dst = append(dst, value)
+			contained.Add(value)
+		}
+	}
+
+	return dst
+}
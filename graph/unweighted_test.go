@@ -1,6 +1,12 @@
 package graph
 
-import "testing"
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/qsoulior/misc/set"
+)
 
 func emptyUnweightedGraph() UnweightedGraph[string] { return make(UnweightedGraph[string]) }
 
@@ -73,3 +79,187 @@ func TestUnweightedGraph_DFS(t *testing.T) {
 		})
 	}
 }
+
+func otherUnweightedGraph() UnweightedGraph[string] {
+	return UnweightedGraph[string]{
+		"you":   []string{"jane", "thom"},
+		"jane":  []string{"peggy"},
+		"peggy": []string{"you"},
+		"thom":  []string{},
+	}
+}
+
+func sortUnweightedGraph(g UnweightedGraph[string]) UnweightedGraph[string] {
+	for _, nodes := range g {
+		slices.Sort(nodes)
+	}
+	return g
+}
+
+func TestUnweightedGraph_Reverse(t *testing.T) {
+	want := UnweightedGraph[string]{
+		"you":    []string{},
+		"bob":    []string{"you"},
+		"jane":   []string{"you"},
+		"claire": []string{"you"},
+		"anuj":   []string{"bob"},
+		"peggy":  []string{"bob", "jane"},
+		"jonny":  []string{"claire"},
+	}
+
+	tests := []struct {
+		name string
+		g    UnweightedGraph[string]
+		want UnweightedGraph[string]
+	}{
+		{"EmptyGraph", emptyUnweightedGraph(), emptyUnweightedGraph()},
+		{"SimpleGraph", simpleUnweightedGraph(), want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sortUnweightedGraph(tt.g.Reverse()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnweightedGraph.Reverse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnweightedGraph_InducedSubgraph(t *testing.T) {
+	want := UnweightedGraph[string]{
+		"you":   []string{"bob", "jane"},
+		"bob":   []string{"peggy"},
+		"jane":  []string{"peggy"},
+		"peggy": []string{},
+	}
+
+	type args struct {
+		nodes set.HashSet[string]
+	}
+	tests := []struct {
+		name string
+		g    UnweightedGraph[string]
+		args args
+		want UnweightedGraph[string]
+	}{
+		{"EmptyGraph", emptyUnweightedGraph(), args{set.HashSet[string]{"you": {}}}, emptyUnweightedGraph()},
+		{"EmptySet", simpleUnweightedGraph(), args{set.HashSet[string]{}}, emptyUnweightedGraph()},
+		{"SimpleGraph", simpleUnweightedGraph(), args{set.HashSet[string]{"you": {}, "bob": {}, "jane": {}, "peggy": {}, "thom": {}}}, want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.InducedSubgraph(tt.args.nodes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnweightedGraph.InducedSubgraph() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnweightedGraph_EdgeFilter(t *testing.T) {
+	want := UnweightedGraph[string]{
+		"you":    []string{"jane"},
+		"bob":    []string{},
+		"jane":   []string{},
+		"claire": []string{"jonny"},
+		"anuj":   []string{},
+		"peggy":  []string{},
+		"jonny":  []string{},
+	}
+
+	type args struct {
+		pred func(from, to string) bool
+	}
+	tests := []struct {
+		name string
+		g    UnweightedGraph[string]
+		args args
+		want UnweightedGraph[string]
+	}{
+		{"EmptyGraph", emptyUnweightedGraph(), args{func(from, to string) bool { return true }}, emptyUnweightedGraph()},
+		{"SimpleGraph", simpleUnweightedGraph(), args{func(from, to string) bool { return unweightedCmp(to) }}, want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.EdgeFilter(tt.args.pred); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnweightedGraph.EdgeFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnweightedGraph_Union(t *testing.T) {
+	want := UnweightedGraph[string]{
+		"you":    []string{"bob", "claire", "jane", "thom"},
+		"bob":    []string{"anuj", "peggy"},
+		"jane":   []string{"peggy"},
+		"claire": []string{"jonny"},
+		"anuj":   []string{},
+		"peggy":  []string{"you"},
+		"jonny":  []string{},
+		"thom":   []string{},
+	}
+
+	type args struct {
+		h UnweightedGraph[string]
+	}
+	tests := []struct {
+		name string
+		g    UnweightedGraph[string]
+		args args
+		want UnweightedGraph[string]
+	}{
+		{"EmptyGraphs", emptyUnweightedGraph(), args{emptyUnweightedGraph()}, emptyUnweightedGraph()},
+		{"EmptyGraph", simpleUnweightedGraph(), args{emptyUnweightedGraph()}, simpleUnweightedGraph()},
+		{"SimpleGraphs", simpleUnweightedGraph(), args{otherUnweightedGraph()}, want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.Union(tt.args.h); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnweightedGraph.Union() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnweightedGraph_Intersection(t *testing.T) {
+	want := UnweightedGraph[string]{
+		"you":   []string{"jane"},
+		"jane":  []string{"peggy"},
+		"peggy": []string{},
+	}
+
+	type args struct {
+		h UnweightedGraph[string]
+	}
+	tests := []struct {
+		name string
+		g    UnweightedGraph[string]
+		args args
+		want UnweightedGraph[string]
+	}{
+		{"EmptyGraph", simpleUnweightedGraph(), args{emptyUnweightedGraph()}, emptyUnweightedGraph()},
+		{"SimpleGraphs", simpleUnweightedGraph(), args{otherUnweightedGraph()}, want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.Intersection(tt.args.h); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnweightedGraph.Intersection() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnweightedGraph_Immutability(t *testing.T) {
+	g, h := simpleUnweightedGraph(), otherUnweightedGraph()
+	g.Reverse()
+	g.InducedSubgraph(set.HashSet[string]{"you": {}})
+	g.EdgeFilter(func(from, to string) bool { return false })
+	g.Union(h)["you"][0] = "thom"
+	g.Intersection(h)
+
+	if !reflect.DeepEqual(g, simpleUnweightedGraph()) {
+		t.Errorf("g = %v, want %v", g, simpleUnweightedGraph())
+	}
+	if !reflect.DeepEqual(h, otherUnweightedGraph()) {
+		t.Errorf("h = %v, want %v", h, otherUnweightedGraph())
+	}
+}
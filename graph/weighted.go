@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"slices"
+
 	"github.com/qsoulior/misc/queue"
 	"github.com/qsoulior/misc/set"
 )
@@ -64,6 +66,13 @@ func (g WeightedGraph[T]) Dijkstra(start T) (map[T]int, map[T]T) {
 // where m is number of edges.
 // Algorithm starts from vertex start and returns distance map and parent map.
 func (g WeightedGraph[T]) QuickDijkstra(start T) (map[T]int, map[T]T) {
+	// Minimum distance has the highest priority.
+	return g.quickDijkstra(start, queue.NewMinPriorityQueue[T]())
+}
+
+// quickDijkstra represents Dijkstra's algorithm based on queue, which should be empty min priority queue.
+// Algorithm starts from vertex start and returns distance map and parent map.
+func (g WeightedGraph[T]) quickDijkstra(start T, queue queue.PriorityQueue[T]) (map[T]int, map[T]T) {
 	if _, ok := g[start]; !ok {
 		return nil, nil
 	}
@@ -71,8 +80,6 @@ func (g WeightedGraph[T]) QuickDijkstra(start T) (map[T]int, map[T]T) {
 	dists := map[T]int{start: 0}
 	parents := make(map[T]T)
 
-	// Minimum distance has the highest priority.
-	queue := queue.NewMinPriorityQueue[T]()
 	queue.Push(start, 0)
 
 	for queue.Len() > 0 {
@@ -95,3 +102,194 @@ func (g WeightedGraph[T]) QuickDijkstra(start T) (map[T]int, map[T]T) {
 
 	return dists, parents
 }
+
+// indexedDijkstra represents Dijkstra's algorithm based on queue, which should be empty indexed min priority queue.
+// Each vertex is contained in queue at most once and its distance is decreased by Update,
+// so complexity is O(m+n*log(n)) with Fibonacci heap.
+// Algorithm starts from vertex start and returns distance map and parent map.
+func (g WeightedGraph[T]) indexedDijkstra(start T, queue queue.IndexedPriorityQueue[T]) (map[T]int, map[T]T) {
+	if _, ok := g[start]; !ok {
+		return nil, nil
+	}
+
+	dists := map[T]int{start: 0}
+	parents := make(map[T]T)
+
+	queue.Push(start, 0)
+
+	for queue.Len() > 0 {
+		minNode, minDist, _ := queue.PopFront()
+
+		// Update minimum distances to neighbors.
+		for neighbor, weight := range g[minNode] {
+			newDist := minDist + weight
+			if dist, ok := dists[neighbor]; !ok || newDist < dist {
+				dists[neighbor] = newDist
+				parents[neighbor] = minNode
+				if !queue.Update(neighbor, newDist) {
+					queue.Push(neighbor, newDist)
+				}
+			}
+		}
+	}
+
+	return dists, parents
+}
+
+// Reverse returns new graph with all edges of g reversed, O(n+m).
+// Every vertex of g is contained in returned graph.
+func (g WeightedGraph[T]) Reverse() WeightedGraph[T] {
+	graph := make(WeightedGraph[T], len(g))
+	for value := range g {
+		graph[value] = make(map[T]int)
+	}
+
+	for value, adjacents := range g {
+		for adjacent, weight := range adjacents {
+			if _, ok := graph[adjacent]; !ok {
+				graph[adjacent] = make(map[T]int)
+			}
+			graph[adjacent][value] = weight
+		}
+	}
+
+	return graph
+}
+
+// InducedSubgraph returns new graph with vertices of g contained in nodes
+// and all edges of g between them, O(n+m).
+func (g WeightedGraph[T]) InducedSubgraph(nodes set.HashSet[T]) WeightedGraph[T] {
+	graph := make(WeightedGraph[T])
+	for value, adjacents := range g {
+		if !nodes.Contains(value) {
+			continue
+		}
+
+		graph[value] = make(map[T]int, len(adjacents))
+		for adjacent, weight := range adjacents {
+			if nodes.Contains(adjacent) {
+				graph[value][adjacent] = weight
+			}
+		}
+	}
+
+	return graph
+}
+
+// EdgeFilter returns new graph with all vertices of g
+// and edges for which pred returns true, O(n+m).
+func (g WeightedGraph[T]) EdgeFilter(pred func(from, to T, weight int) bool) WeightedGraph[T] {
+	graph := make(WeightedGraph[T], len(g))
+	for value, adjacents := range g {
+		graph[value] = make(map[T]int, len(adjacents))
+		for adjacent, weight := range adjacents {
+			if pred(value, adjacent, weight) {
+				graph[value][adjacent] = weight
+			}
+		}
+	}
+
+	return graph
+}
+
+// Union returns new graph with vertices and edges from both g and h,
+// complexity is O(n+m) where n and m are total numbers of vertices and edges.
+// If edge is contained in both graphs, its weight is taken from g.
+func (g WeightedGraph[T]) Union(h WeightedGraph[T]) WeightedGraph[T] {
+	graph := make(WeightedGraph[T], len(g))
+	for _, src := range []WeightedGraph[T]{h, g} {
+		for value, adjacents := range src {
+			if _, ok := graph[value]; !ok {
+				graph[value] = make(map[T]int, len(adjacents))
+			}
+			for adjacent, weight := range adjacents {
+				graph[value][adjacent] = weight
+			}
+		}
+	}
+
+	return graph
+}
+
+// Intersection returns new graph with vertices and edges common to g and h,
+// complexity is O(n+m) where n and m are total numbers of vertices and edges.
+// Weights of edges are taken from g.
+func (g WeightedGraph[T]) Intersection(h WeightedGraph[T]) WeightedGraph[T] {
+	graph := make(WeightedGraph[T])
+	for value, adjacents := range g {
+		others, ok := h[value]
+		if !ok {
+			continue
+		}
+
+		graph[value] = make(map[T]int)
+		for adjacent, weight := range adjacents {
+			if _, ok := others[adjacent]; ok {
+				graph[value][adjacent] = weight
+			}
+		}
+	}
+
+	return graph
+}
+
+// AStar represents A* search algorithm with complexity O(m*log(m)),
+// where m is number of edges.
+// Algorithm starts from vertex start and stops when vertex target is reached.
+// heuristic should return estimated distance from vertex to target that is never overestimated.
+// It returns distance map and parent map of processed vertices.
+func (g WeightedGraph[T]) AStar(start, target T, heuristic func(value T) int) (map[T]int, map[T]T) {
+	if _, ok := g[start]; !ok {
+		return nil, nil
+	}
+
+	dists := map[T]int{start: 0}
+	parents := make(map[T]T)
+
+	// Minimum estimated distance to target has the highest priority.
+	queue := queue.NewMinPriorityQueue[T]()
+	queue.Push(start, heuristic(start))
+
+	for queue.Len() > 0 {
+		// PopFront returns node with minimum estimated distance, O(log(m)).
+		minNode, minEstimate, _ := queue.PopFront()
+		minDist := dists[minNode]
+		if minEstimate-heuristic(minNode) > minDist {
+			continue
+		}
+
+		if minNode == target {
+			break
+		}
+
+		// Update minimum distances to neighbors.
+		for neighbor, weight := range g[minNode] {
+			newDist := minDist + weight
+			if dist, ok := dists[neighbor]; !ok || newDist < dist {
+				dists[neighbor] = newDist
+				parents[neighbor] = minNode
+				queue.Push(neighbor, newDist+heuristic(neighbor))
+			}
+		}
+	}
+
+	return dists, parents
+}
+
+// Path returns path from start to target restored from parent map, O(n).
+// Parent map should be returned by Dijkstra, QuickDijkstra or AStar started from start.
+// If target is unreachable, it returns nil.
+func Path[T comparable](parents map[T]T, start, target T) []T {
+	path := []T{target}
+	for node := target; node != start; {
+		parent, ok := parents[node]
+		if !ok {
+			return nil
+		}
+		path = append(path, parent)
+		node = parent
+	}
+
+	slices.Reverse(path)
+	return path
+}
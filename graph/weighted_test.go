@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"slices"
 	"testing"
+
+	"github.com/qsoulior/misc/set"
 )
 
 func emptyWeightedGraph() WeightedGraph[string] { return make(WeightedGraph[string]) }
@@ -107,3 +109,231 @@ func TestWeightedGraph_QuickDijkstra(t *testing.T) {
 		})
 	}
 }
+
+func otherWeightedGraph() WeightedGraph[string] {
+	return WeightedGraph[string]{
+		"book":   map[string]int{"record": 10, "lamp": 1},
+		"record": map[string]int{"drum": 5},
+		"lamp":   map[string]int{},
+	}
+}
+
+func TestWeightedGraph_Reverse(t *testing.T) {
+	want := WeightedGraph[string]{
+		"book":   map[string]int{},
+		"record": map[string]int{"book": 5},
+		"poster": map[string]int{"book": 0},
+		"guitar": map[string]int{"record": 15, "poster": 30},
+		"drum":   map[string]int{"record": 20, "poster": 35},
+		"piano":  map[string]int{"guitar": 20, "drum": 10},
+	}
+
+	tests := []struct {
+		name string
+		g    WeightedGraph[string]
+		want WeightedGraph[string]
+	}{
+		{"EmptyGraph", emptyWeightedGraph(), emptyWeightedGraph()},
+		{"SimpleGraph", simpleWeightedGraph(), want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.Reverse(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WeightedGraph.Reverse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeightedGraph_InducedSubgraph(t *testing.T) {
+	want := WeightedGraph[string]{
+		"book":   map[string]int{"record": 5},
+		"record": map[string]int{"drum": 20},
+		"drum":   map[string]int{},
+	}
+
+	type args struct {
+		nodes set.HashSet[string]
+	}
+	tests := []struct {
+		name string
+		g    WeightedGraph[string]
+		args args
+		want WeightedGraph[string]
+	}{
+		{"EmptyGraph", emptyWeightedGraph(), args{set.HashSet[string]{"book": {}}}, emptyWeightedGraph()},
+		{"EmptySet", simpleWeightedGraph(), args{set.HashSet[string]{}}, emptyWeightedGraph()},
+		{"SimpleGraph", simpleWeightedGraph(), args{set.HashSet[string]{"book": {}, "record": {}, "drum": {}}}, want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.InducedSubgraph(tt.args.nodes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WeightedGraph.InducedSubgraph() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeightedGraph_EdgeFilter(t *testing.T) {
+	want := WeightedGraph[string]{
+		"book":   map[string]int{"record": 5, "poster": 0},
+		"record": map[string]int{"guitar": 15},
+		"poster": map[string]int{},
+		"guitar": map[string]int{},
+		"drum":   map[string]int{"piano": 10},
+	}
+
+	type args struct {
+		pred func(from, to string, weight int) bool
+	}
+	tests := []struct {
+		name string
+		g    WeightedGraph[string]
+		args args
+		want WeightedGraph[string]
+	}{
+		{"EmptyGraph", emptyWeightedGraph(), args{func(from, to string, weight int) bool { return true }}, emptyWeightedGraph()},
+		{"SimpleGraph", simpleWeightedGraph(), args{func(from, to string, weight int) bool { return weight < 20 }}, want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.EdgeFilter(tt.args.pred); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WeightedGraph.EdgeFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeightedGraph_Union(t *testing.T) {
+	want := WeightedGraph[string]{
+		"book":   map[string]int{"record": 5, "poster": 0, "lamp": 1},
+		"record": map[string]int{"guitar": 15, "drum": 20},
+		"poster": map[string]int{"guitar": 30, "drum": 35},
+		"guitar": map[string]int{"piano": 20},
+		"drum":   map[string]int{"piano": 10},
+		"lamp":   map[string]int{},
+	}
+
+	type args struct {
+		h WeightedGraph[string]
+	}
+	tests := []struct {
+		name string
+		g    WeightedGraph[string]
+		args args
+		want WeightedGraph[string]
+	}{
+		{"EmptyGraphs", emptyWeightedGraph(), args{emptyWeightedGraph()}, emptyWeightedGraph()},
+		{"EmptyGraph", simpleWeightedGraph(), args{emptyWeightedGraph()}, simpleWeightedGraph()},
+		{"SimpleGraphs", simpleWeightedGraph(), args{otherWeightedGraph()}, want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.Union(tt.args.h); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WeightedGraph.Union() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeightedGraph_Intersection(t *testing.T) {
+	want := WeightedGraph[string]{
+		"book":   map[string]int{"record": 5},
+		"record": map[string]int{"drum": 20},
+	}
+
+	type args struct {
+		h WeightedGraph[string]
+	}
+	tests := []struct {
+		name string
+		g    WeightedGraph[string]
+		args args
+		want WeightedGraph[string]
+	}{
+		{"EmptyGraph", simpleWeightedGraph(), args{emptyWeightedGraph()}, emptyWeightedGraph()},
+		{"SimpleGraphs", simpleWeightedGraph(), args{otherWeightedGraph()}, want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.Intersection(tt.args.h); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WeightedGraph.Intersection() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeightedGraph_Immutability(t *testing.T) {
+	g, h := simpleWeightedGraph(), otherWeightedGraph()
+	g.Reverse()["record"]["book"] = 1
+	g.InducedSubgraph(set.HashSet[string]{"book": {}})["book"]["poster"] = 1
+	g.EdgeFilter(func(from, to string, weight int) bool { return true })["book"]["record"] = 1
+	g.Union(h)["book"]["record"] = 1
+	g.Intersection(h)["book"]["record"] = 1
+
+	if !reflect.DeepEqual(g, simpleWeightedGraph()) {
+		t.Errorf("g = %v, want %v", g, simpleWeightedGraph())
+	}
+	if !reflect.DeepEqual(h, otherWeightedGraph()) {
+		t.Errorf("h = %v, want %v", h, otherWeightedGraph())
+	}
+}
+
+func TestWeightedGraph_AStar(t *testing.T) {
+	dists := map[string]int{"book": 0, "drum": 25, "guitar": 20, "piano": 35, "poster": 0, "record": 5}
+
+	type args struct {
+		start     string
+		target    string
+		heuristic func(value string) int
+	}
+	tests := []struct {
+		name  string
+		g     WeightedGraph[string]
+		args  args
+		want  int
+		want1 []string
+	}{
+		{"EmptyGraph", emptyWeightedGraph(), args{"book", "piano", func(string) int { return 0 }}, 0, nil},
+		{"ZeroHeuristic", simpleWeightedGraph(), args{"book", "piano", func(string) int { return 0 }}, 35, []string{"book", "record", "drum", "piano"}},
+		{"DistanceHeuristic", simpleWeightedGraph(), args{"book", "piano", func(value string) int { return dists["piano"] - dists[value] }}, 35, []string{"book", "record", "drum", "piano"}},
+		{"UnreachableTarget", simpleWeightedGraph(), args{"piano", "book", func(string) int { return 0 }}, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.g.AStar(tt.args.start, tt.args.target, tt.args.heuristic)
+			if got[tt.args.target] != tt.want {
+				t.Errorf("WeightedGraph.AStar() got = %v, want %v", got[tt.args.target], tt.want)
+			}
+			if path := Path(got1, tt.args.start, tt.args.target); !reflect.DeepEqual(path, tt.want1) {
+				t.Errorf("WeightedGraph.AStar() path = %v, want %v", path, tt.want1)
+			}
+		})
+	}
+}
+
+func TestPath(t *testing.T) {
+	parents := map[string]string{"drum": "record", "guitar": "record", "piano": "drum", "poster": "book", "record": "book"}
+
+	type args struct {
+		parents map[string]string
+		start   string
+		target  string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{"SameVertex", args{nil, "book", "book"}, []string{"book"}},
+		{"UnreachableTarget", args{parents, "book", "lamp"}, nil},
+		{"SimplePath", args{parents, "book", "piano"}, []string{"book", "record", "drum", "piano"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Path(tt.args.parents, tt.args.start, tt.args.target); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Path() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
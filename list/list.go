@@ -277,7 +277,9 @@ func (l *CircularLinkedList[T]) PushFront(value T) *Node[T] {
 		return node
 	}
 
-	return l.InsertBefore(value, l.head)
+	// Node inserted before the head becomes the last one, so move the head to it.
+	l.head = l.InsertBefore(value, l.head)
+	return l.head
 }
 
 // PushBack inserts new node with value at back of list, O(1).
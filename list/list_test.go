@@ -464,9 +464,13 @@ func TestCircularLinkedList_PushFront(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.l.PushFront(tt.args.value); !reflect.DeepEqual(got, tt.want) {
+			got := tt.l.PushFront(tt.args.value)
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("CircularLinkedList.PushFront() = %v, want %v", got, tt.want)
 			}
+			if front := tt.l.Front(); front != got {
+				t.Errorf("CircularLinkedList.Front() = %v after PushFront(), want %v", front, got)
+			}
 		})
 	}
 }
@@ -0,0 +1,189 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrClosed is returned when value is pushed into closed queue
+// or popped from closed and empty queue.
+var ErrClosed = errors.New("queue: closed")
+
+// BlockingQueue represents abstract queue that is safe for concurrent use.
+// Its operations block while queue is full or empty.
+type BlockingQueue[T any] interface {
+	// Len returns number of elements contained in queue.
+	Len() int
+	// Cap returns maximum number of elements contained in queue or 0 if queue is unbounded.
+	Cap() int
+	// PushBack inserts new value at back of queue, blocking while queue is full.
+	// It returns ErrClosed if queue is closed or ctx.Err() if ctx is done.
+	PushBack(ctx context.Context, value T) error
+	// PopFront removes first element from queue and returns it, blocking while queue is empty.
+	// It returns ErrClosed if queue is closed and empty or ctx.Err() if ctx is done.
+	PopFront(ctx context.Context) (T, error)
+	// TryPush inserts new value at back of queue without blocking.
+	// It returns false if queue is full or closed.
+	TryPush(value T) bool
+	// TryPop removes first element from queue and returns it without blocking.
+	// If queue is empty, it returns default value of type T and false as second value.
+	TryPop() (T, bool)
+	// Close closes queue, so that pushing fails and popping fails after queue is drained.
+	// Closing closed queue has no effect.
+	Close()
+}
+
+// blockingQueue implements blocking queue based on underlying queue protected by mutex.
+type blockingQueue[T any] struct {
+	mu      sync.Mutex
+	data    Queue[T]
+	cap     int
+	closed  bool
+	changed broadcast // notified when queue changes
+}
+
+// NewBlockingQueue returns new blocking queue based on q bounded by capacity.
+// If capacity is less than or equal to 0, queue is unbounded.
+// q should not be used directly after calling NewBlockingQueue.
+func NewBlockingQueue[T any](q Queue[T], capacity int) BlockingQueue[T] {
+	return &blockingQueue[T]{data: q, cap: max(capacity, 0)}
+}
+
+// Len returns number of elements contained in queue, O(1).
+func (q *blockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.data.Len()
+}
+
+// Cap returns maximum number of elements contained in queue or 0 if queue is unbounded, O(1).
+func (q *blockingQueue[T]) Cap() int { return q.cap }
+
+// PushBack inserts new value at back of queue, blocking while queue is full.
+// It returns ErrClosed if queue is closed or ctx.Err() if ctx is done.
+func (q *blockingQueue[T]) PushBack(ctx context.Context, value T) error {
+	q.mu.Lock()
+	for {
+		if q.closed {
+			q.mu.Unlock()
+			return ErrClosed
+		}
+
+		if !q.full() {
+			q.data.PushBack(value)
+			q.changed.notify()
+			q.mu.Unlock()
+			return nil
+		}
+
+		if err := q.changed.wait(ctx, &q.mu); err != nil {
+			q.mu.Unlock()
+			return err
+		}
+	}
+}
+
+// PopFront removes first element from queue and returns it, blocking while queue is empty.
+// It returns ErrClosed if queue is closed and empty or ctx.Err() if ctx is done.
+func (q *blockingQueue[T]) PopFront(ctx context.Context) (T, error) {
+	q.mu.Lock()
+	for {
+		if value, ok := q.data.PopFront(); ok {
+			q.changed.notify()
+			q.mu.Unlock()
+			return value, nil
+		}
+
+		if q.closed {
+			q.mu.Unlock()
+			var value T
+			return value, ErrClosed
+		}
+
+		if err := q.changed.wait(ctx, &q.mu); err != nil {
+			q.mu.Unlock()
+			var value T
+			return value, err
+		}
+	}
+}
+
+// TryPush inserts new value at back of queue without blocking.
+// It returns false if queue is full or closed.
+func (q *blockingQueue[T]) TryPush(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed || q.full() {
+		return false
+	}
+
+	q.data.PushBack(value)
+	q.changed.notify()
+	return true
+}
+
+// TryPop removes first element from queue and returns it without blocking.
+// If queue is empty, it returns default value of type T and false as second value.
+func (q *blockingQueue[T]) TryPop() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	value, ok := q.data.PopFront()
+	if ok {
+		q.changed.notify()
+	}
+
+	return value, ok
+}
+
+// Close closes queue, so that pushing fails and popping fails after queue is drained.
+// Closing closed queue has no effect.
+func (q *blockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.closed {
+		q.closed = true
+		q.changed.notify()
+	}
+}
+
+// full returns true if queue is bounded and contains maximum number of elements.
+// It must be called with mutex locked.
+func (q *blockingQueue[T]) full() bool { return q.cap > 0 && q.data.Len() >= q.cap }
+
+// broadcast wakes up all goroutines waiting for change of state protected by mutex.
+// Zero value is ready to use.
+type broadcast struct {
+	ch chan struct{} // closed on notification, nil if nobody waits
+}
+
+// notify wakes up all waiting goroutines.
+// It must be called with mutex locked.
+func (b *broadcast) notify() {
+	if b.ch != nil {
+		close(b.ch)
+		b.ch = nil
+	}
+}
+
+// wait unlocks mu and blocks until notify is called or ctx is done.
+// It locks mu again before returning. If ctx is done, it returns ctx.Err().
+// It must be called with mu locked.
+func (b *broadcast) wait(ctx context.Context, mu sync.Locker) error {
+	if b.ch == nil {
+		b.ch = make(chan struct{})
+	}
+	ch := b.ch
+	mu.Unlock()
+	defer mu.Lock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ch:
+		return nil
+	}
+}
@@ -0,0 +1,227 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func emptyBlockingQueue() BlockingQueue[int] { return NewBlockingQueue(NewListQueue[int](), 1) }
+
+func simpleBlockingQueue() BlockingQueue[int] {
+	q := NewBlockingQueue(NewListQueue[int](), 1)
+	q.TryPush(1)
+	return q
+}
+
+func closedBlockingQueue() BlockingQueue[int] {
+	q := simpleBlockingQueue()
+	q.Close()
+	return q
+}
+
+// cancelledContext returns context that is already done.
+func cancelledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+func TestNewBlockingQueue(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		want     int
+	}{
+		{"Unbounded", -1, 0},
+		{"Bounded", 2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewBlockingQueue(NewListQueue[int](), tt.capacity).Cap(); got != tt.want {
+				t.Errorf("BlockingQueue.Cap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockingQueue_PushBack(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		value int
+	}
+	tests := []struct {
+		name string
+		q    BlockingQueue[int]
+		args args
+		want error
+	}{
+		{"EmptyQueue", emptyBlockingQueue(), args{context.Background(), 2}, nil},
+		{"FullQueue", simpleBlockingQueue(), args{cancelledContext(), 2}, context.Canceled},
+		{"ClosedQueue", closedBlockingQueue(), args{context.Background(), 2}, ErrClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.q.PushBack(tt.args.ctx, tt.args.value); !errors.Is(got, tt.want) {
+				t.Errorf("BlockingQueue.PushBack() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockingQueue_PopFront(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name  string
+		q     BlockingQueue[int]
+		args  args
+		want  int
+		want1 error
+	}{
+		{"EmptyQueue", emptyBlockingQueue(), args{cancelledContext()}, 0, context.Canceled},
+		{"SimpleQueue", simpleBlockingQueue(), args{context.Background()}, 1, nil},
+		{"ClosedQueue", closedBlockingQueue(), args{context.Background()}, 1, nil},
+		{"ClosedEmptyQueue", func() BlockingQueue[int] { q := emptyBlockingQueue(); q.Close(); return q }(), args{context.Background()}, 0, ErrClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.q.PopFront(tt.args.ctx)
+			if got != tt.want {
+				t.Errorf("BlockingQueue.PopFront() got = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(got1, tt.want1) {
+				t.Errorf("BlockingQueue.PopFront() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestBlockingQueue_TryPush(t *testing.T) {
+	tests := []struct {
+		name string
+		q    BlockingQueue[int]
+		want bool
+	}{
+		{"EmptyQueue", emptyBlockingQueue(), true},
+		{"FullQueue", simpleBlockingQueue(), false},
+		{"ClosedQueue", func() BlockingQueue[int] { q := emptyBlockingQueue(); q.Close(); return q }(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.q.TryPush(2); got != tt.want {
+				t.Errorf("BlockingQueue.TryPush() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockingQueue_TryPop(t *testing.T) {
+	tests := []struct {
+		name  string
+		q     BlockingQueue[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyBlockingQueue(), 0, false},
+		{"SimpleQueue", simpleBlockingQueue(), 1, true},
+		{"ClosedQueue", closedBlockingQueue(), 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.q.TryPop()
+			if got != tt.want {
+				t.Errorf("BlockingQueue.TryPop() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("BlockingQueue.TryPop() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestBlockingQueue_Timeout(t *testing.T) {
+	q := simpleBlockingQueue()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := q.PushBack(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("BlockingQueue.PushBack() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got := q.Len(); got != 1 {
+		t.Errorf("BlockingQueue.Len() = %v, want %v", got, 1)
+	}
+}
+
+func TestBlockingQueue_Close(t *testing.T) {
+	q := emptyBlockingQueue()
+	errs := make(chan error)
+	for range 3 {
+		go func() {
+			_, err := q.PopFront(context.Background())
+			errs <- err
+		}()
+	}
+
+	q.Close()
+	q.Close()
+	for range 3 {
+		if err := <-errs; !errors.Is(err, ErrClosed) {
+			t.Errorf("BlockingQueue.PopFront() = %v after Close(), want %v", err, ErrClosed)
+		}
+	}
+}
+
+func TestBlockingQueue_Concurrent(t *testing.T) {
+	const producers, consumers, n = 4, 4, 1000
+	q := NewBlockingQueue(NewListQueue[int](), 8)
+
+	var wg sync.WaitGroup
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range n {
+				if err := q.PushBack(context.Background(), p*n+i); err != nil {
+					t.Errorf("BlockingQueue.PushBack() = %v", err)
+				}
+			}
+		}()
+	}
+
+	results := make(chan []int, consumers)
+	for range consumers {
+		go func() {
+			var got []int
+			for {
+				value, err := q.PopFront(context.Background())
+				if err != nil {
+					results <- got
+					return
+				}
+				got = append(got, value)
+			}
+		}()
+	}
+
+	wg.Wait()
+	q.Close()
+
+	var got []int
+	for range consumers {
+		got = append(got, <-results...)
+	}
+	slices.Sort(got)
+
+	want := make([]int, producers*n)
+	for i := range want {
+		want[i] = i
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("popped %v elements, want %v", len(got), len(want))
+	}
+}
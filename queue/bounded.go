@@ -0,0 +1,96 @@
+package queue
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// BoundedPriorityQueue represents abstract priority queue
+// that contains at most specified number of the highest-priority elements.
+type BoundedPriorityQueue[T any] interface {
+	// Len returns number of elements contained in queue.
+	Len() int
+	// Cap returns maximum number of elements contained in queue.
+	Cap() int
+	// Back returns the lowest-priority element and its priority.
+	// If queue is empty, it returns default value of type T and false as third value.
+	Back() (T, int, bool)
+	// Push inserts new value with priority into queue.
+	// If queue is full, the lowest-priority element is evicted.
+	// It returns the evicted element and its priority.
+	// If no element is evicted, it returns default value of type T and false as third value.
+	Push(value T, priority int) (T, int, bool)
+	// Drain removes all elements from queue
+	// and returns them in order from the highest to the lowest priority.
+	Drain() []PriorityItem[T]
+}
+
+// boundedPriorityQueue implements bounded priority queue based on min/max heap.
+// Element with the lowest priority has min/max value in heap.
+type boundedPriorityQueue[T any] struct {
+	data prioritySlice[T]
+	cap  int
+}
+
+// NewMinBoundedPriorityQueue returns new bounded priority queue
+// that contains at most k elements with minimum priority values.
+func NewMinBoundedPriorityQueue[T any](k int) BoundedPriorityQueue[T] {
+	return &boundedPriorityQueue[T]{new(maxPrioritySlice[T]), max(k, 0)}
+}
+
+// NewMaxBoundedPriorityQueue returns new bounded priority queue
+// that contains at most k elements with maximum priority values.
+func NewMaxBoundedPriorityQueue[T any](k int) BoundedPriorityQueue[T] {
+	return &boundedPriorityQueue[T]{new(minPrioritySlice[T]), max(k, 0)}
+}
+
+// Len returns number of elements contained in queue, O(1).
+func (p boundedPriorityQueue[T]) Len() int { return p.data.Len() }
+
+// Cap returns maximum number of elements contained in queue, O(1).
+func (p boundedPriorityQueue[T]) Cap() int { return p.cap }
+
+// Back returns element of queue that has the lowest priority in heap, O(1).
+// It also returns element's priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p boundedPriorityQueue[T]) Back() (T, int, bool) {
+	if p.data.Len() > 0 {
+		item := p.data.First()
+		return item.value, item.priority, true
+	}
+
+	var value T
+	return value, 0, false
+}
+
+// Push inserts new value with priority into queue, O(log(k)).
+// If queue is full, the lowest-priority element is evicted,
+// it may be the inserted element itself.
+// It returns the evicted element and its priority.
+// If no element is evicted, it returns default value of type T and false as third value.
+func (p *boundedPriorityQueue[T]) Push(value T, priority int) (T, int, bool) {
+	if p.cap == 0 {
+		return value, priority, true
+	}
+
+	heap.Push(p.data, &PriorityItem[T]{value: value, priority: priority})
+	if p.data.Len() > p.cap {
+		item := heap.Pop(p.data).(*PriorityItem[T])
+		return item.value, item.priority, true
+	}
+
+	var zero T
+	return zero, 0, false
+}
+
+// Drain removes all elements from queue, O(k*log(k)).
+// It returns them in order from the highest to the lowest priority.
+func (p *boundedPriorityQueue[T]) Drain() []PriorityItem[T] {
+	items := make([]PriorityItem[T], 0, p.data.Len())
+	for p.data.Len() > 0 {
+		items = append(items, *heap.Pop(p.data).(*PriorityItem[T]))
+	}
+
+	slices.Reverse(items)
+	return items
+}
@@ -0,0 +1,162 @@
+package queue
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func emptyBoundedPriorityQueue() BoundedPriorityQueue[int] { return NewMaxBoundedPriorityQueue[int](2) }
+
+func simpleBoundedPriorityQueue() BoundedPriorityQueue[int] {
+	pq := NewMaxBoundedPriorityQueue[int](2)
+	pq.Push(1, 2)
+	pq.Push(2, 3)
+	return pq
+}
+
+// drainPriorities drains queue and returns priorities of drained items.
+func drainPriorities(p BoundedPriorityQueue[int]) []int {
+	items := p.Drain()
+	priorities := make([]int, len(items))
+	for i, item := range items {
+		priorities[i] = item.Priority()
+	}
+	return priorities
+}
+
+func TestBoundedPriorityQueue_Cap(t *testing.T) {
+	tests := []struct {
+		name string
+		p    BoundedPriorityQueue[int]
+		want int
+	}{
+		{"NegativeCap", NewMinBoundedPriorityQueue[int](-1), 0},
+		{"SimpleQueue", simpleBoundedPriorityQueue(), 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Cap(); got != tt.want {
+				t.Errorf("BoundedPriorityQueue.Cap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoundedPriorityQueue_Back(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     BoundedPriorityQueue[int]
+		want  int
+		want1 int
+		want2 bool
+	}{
+		{"EmptyQueue", emptyBoundedPriorityQueue(), 0, 0, false},
+		{"SimpleQueue", simpleBoundedPriorityQueue(), 1, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2 := tt.p.Back()
+			if got != tt.want {
+				t.Errorf("BoundedPriorityQueue.Back() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("BoundedPriorityQueue.Back() got1 = %v, want %v", got1, tt.want1)
+			}
+			if got2 != tt.want2 {
+				t.Errorf("BoundedPriorityQueue.Back() got2 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+}
+
+func TestBoundedPriorityQueue_Push(t *testing.T) {
+	type args struct {
+		value    int
+		priority int
+	}
+	tests := []struct {
+		name  string
+		p     BoundedPriorityQueue[int]
+		args  args
+		want  int
+		want1 int
+		want2 bool
+		want3 int
+	}{
+		{"ZeroCap", NewMaxBoundedPriorityQueue[int](0), args{3, 4}, 3, 4, true, 0},
+		{"EmptyQueue", emptyBoundedPriorityQueue(), args{3, 4}, 0, 0, false, 1},
+		{"EvictOther", simpleBoundedPriorityQueue(), args{3, 4}, 1, 2, true, 2},
+		{"EvictInserted", simpleBoundedPriorityQueue(), args{3, 1}, 3, 1, true, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2 := tt.p.Push(tt.args.value, tt.args.priority)
+			if got != tt.want {
+				t.Errorf("BoundedPriorityQueue.Push() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("BoundedPriorityQueue.Push() got1 = %v, want %v", got1, tt.want1)
+			}
+			if got2 != tt.want2 {
+				t.Errorf("BoundedPriorityQueue.Push() got2 = %v, want %v", got2, tt.want2)
+			}
+			if got3 := tt.p.Len(); got3 != tt.want3 {
+				t.Errorf("BoundedPriorityQueue.Len() = %v, want %v", got3, tt.want3)
+			}
+		})
+	}
+}
+
+func TestBoundedPriorityQueue_Drain(t *testing.T) {
+	tests := []struct {
+		name string
+		p    BoundedPriorityQueue[int]
+		want []int
+	}{
+		{"EmptyQueue", emptyBoundedPriorityQueue(), []int{}},
+		{"SimpleQueue", simpleBoundedPriorityQueue(), []int{3, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := drainPriorities(tt.p); !slices.Equal(got, tt.want) {
+				t.Errorf("BoundedPriorityQueue.Drain() = %v, want %v", got, tt.want)
+			}
+			if got := tt.p.Len(); got != 0 {
+				t.Errorf("BoundedPriorityQueue.Len() = %v after Drain(), want %v", got, 0)
+			}
+		})
+	}
+}
+
+func TestBoundedPriorityQueue_TopK(t *testing.T) {
+	const k = 10
+	tests := []struct {
+		name string
+		p    BoundedPriorityQueue[int]
+		cmp  func(a, b int) int
+	}{
+		{"MinQueue", NewMinBoundedPriorityQueue[int](k), func(a, b int) int { return a - b }},
+		{"MaxQueue", NewMaxBoundedPriorityQueue[int](k), func(a, b int) int { return b - a }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priorities := make([]int, 1000)
+			evicted := 0
+			for i := range priorities {
+				priorities[i] = rand.Intn(1000)
+				if _, _, ok := tt.p.Push(i, priorities[i]); ok {
+					evicted++
+				}
+			}
+
+			slices.SortFunc(priorities, tt.cmp)
+			if got := drainPriorities(tt.p); !slices.Equal(got, priorities[:k]) {
+				t.Errorf("BoundedPriorityQueue.Drain() = %v, want %v", got, priorities[:k])
+			}
+			if evicted != len(priorities)-k {
+				t.Errorf("evicted %v elements, want %v", evicted, len(priorities)-k)
+			}
+		})
+	}
+}
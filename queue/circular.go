@@ -0,0 +1,278 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrFull is returned when value is rejected because buffer is full.
+var ErrFull = errors.New("queue: full")
+
+// OverflowPolicy represents behavior of bounded buffer when value is pushed into full buffer.
+type OverflowPolicy int
+
+const (
+	// Overwrite evicts element at the opposite end of buffer to make room for new value.
+	Overwrite OverflowPolicy = iota
+	// Reject discards new value and leaves buffer unchanged.
+	Reject
+	// Block waits until another goroutine pops element from buffer or buffer is closed.
+	Block
+)
+
+// CircularBuffer represents abstract fixed-capacity double-ended queue.
+type CircularBuffer[T any] interface {
+	Deque[T]
+	// Cap returns maximum number of elements contained in buffer.
+	Cap() int
+	// Full returns true if buffer contains maximum number of elements.
+	Full() bool
+	// At returns element with index i counting from front of buffer.
+	// If i is out of range, it returns default value of type T and false as second value.
+	At(i int) (T, bool)
+	// Snapshot returns new slice with elements of buffer from front to back.
+	Snapshot() []T
+	// TryPushBack inserts new value at back of buffer without blocking.
+	// It returns false if value is rejected by overflow policy, would block or buffer is closed.
+	TryPushBack(value T) bool
+	// TryPushFront inserts new value at front of buffer without blocking.
+	// It returns false if value is rejected by overflow policy, would block or buffer is closed.
+	TryPushFront(value T) bool
+	// PushBackContext inserts new value at back of buffer according to overflow policy.
+	// It returns ErrFull if value is rejected, ErrClosed if buffer is closed
+	// or ctx.Err() if ctx is done while blocking.
+	PushBackContext(ctx context.Context, value T) error
+	// PushFrontContext inserts new value at front of buffer according to overflow policy.
+	// It returns ErrFull if value is rejected, ErrClosed if buffer is closed
+	// or ctx.Err() if ctx is done while blocking.
+	PushFrontContext(ctx context.Context, value T) error
+	// Close closes buffer, so that pushing fails and blocked pushes return.
+	// Elements can still be popped. Closing closed buffer has no effect.
+	Close()
+}
+
+// circularBuffer implements circular buffer based on fixed-size slice.
+// It is safe for concurrent use.
+type circularBuffer[T any] struct {
+	mu      sync.Mutex
+	buf     []T
+	head    int // index of the first element
+	len     int
+	policy  OverflowPolicy
+	closed  bool
+	notFull broadcast // notified when element is popped or buffer is closed
+}
+
+// NewCircularBuffer returns new circular buffer with specified capacity and overflow policy.
+// If capacity is less than 1, buffer can contain one element.
+func NewCircularBuffer[T any](capacity int, policy OverflowPolicy) CircularBuffer[T] {
+	return &circularBuffer[T]{buf: make([]T, max(capacity, 1)), policy: policy}
+}
+
+// Len returns number of elements contained in buffer, O(1).
+func (b *circularBuffer[T]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.len
+}
+
+// Cap returns maximum number of elements contained in buffer, O(1).
+func (b *circularBuffer[T]) Cap() int { return len(b.buf) }
+
+// Full returns true if buffer contains maximum number of elements, O(1).
+func (b *circularBuffer[T]) Full() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.len == len(b.buf)
+}
+
+// Front returns first element of buffer, O(1).
+// If buffer is empty, it returns default value of type T and false as second value.
+func (b *circularBuffer[T]) Front() (T, bool) { return b.At(0) }
+
+// Back returns last element of buffer, O(1).
+// If buffer is empty, it returns default value of type T and false as second value.
+func (b *circularBuffer[T]) Back() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.at(b.len - 1)
+}
+
+// At returns element with index i counting from front of buffer, O(1).
+// If i is out of range, it returns default value of type T and false as second value.
+func (b *circularBuffer[T]) At(i int) (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.at(i)
+}
+
+// Snapshot returns new slice with elements of buffer from front to back, O(n).
+func (b *circularBuffer[T]) Snapshot() []T {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s := make([]T, b.len)
+	n := copy(s, b.buf[b.head:min(b.head+b.len, len(b.buf))])
+	copy(s[n:], b.buf[:b.len-n])
+	return s
+}
+
+// PopFront removes first element from buffer and returns it, O(1).
+// If buffer is empty, it returns default value of type T and false as second value.
+func (b *circularBuffer[T]) PopFront() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	value, ok := b.at(0)
+	if ok {
+		var zero T
+		b.buf[b.head] = zero // avoid memory leaks
+		b.head = b.index(1)
+		b.len--
+		b.notFull.notify()
+	}
+
+	return value, ok
+}
+
+// PopBack removes last element from buffer and returns it, O(1).
+// If buffer is empty, it returns default value of type T and false as second value.
+func (b *circularBuffer[T]) PopBack() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	value, ok := b.at(b.len - 1)
+	if ok {
+		var zero T
+		b.buf[b.index(b.len-1)] = zero // avoid memory leaks
+		b.len--
+		b.notFull.notify()
+	}
+
+	return value, ok
+}
+
+// PushBack inserts new value at back of buffer, O(1).
+// If buffer is full, it evicts the first element, discards value or blocks according to overflow policy.
+// Value is discarded if buffer is closed. Use TryPushBack or PushBackContext to detect it.
+// It returns the inserted value.
+func (b *circularBuffer[T]) PushBack(value T) T {
+	b.push(context.Background(), value, true, true)
+	return value
+}
+
+// PushFront inserts new value at front of buffer, O(1).
+// If buffer is full, it evicts the last element, discards value or blocks according to overflow policy.
+// Value is discarded if buffer is closed. Use TryPushFront or PushFrontContext to detect it.
+// It returns the inserted value.
+func (b *circularBuffer[T]) PushFront(value T) T {
+	b.push(context.Background(), value, false, true)
+	return value
+}
+
+// TryPushBack inserts new value at back of buffer without blocking, O(1).
+// It returns false if value is rejected by overflow policy, would block or buffer is closed.
+func (b *circularBuffer[T]) TryPushBack(value T) bool {
+	return b.push(context.Background(), value, true, false) == nil
+}
+
+// TryPushFront inserts new value at front of buffer without blocking, O(1).
+// It returns false if value is rejected by overflow policy, would block or buffer is closed.
+func (b *circularBuffer[T]) TryPushFront(value T) bool {
+	return b.push(context.Background(), value, false, false) == nil
+}
+
+// PushBackContext inserts new value at back of buffer according to overflow policy.
+// It returns ErrFull if value is rejected, ErrClosed if buffer is closed
+// or ctx.Err() if ctx is done while blocking.
+func (b *circularBuffer[T]) PushBackContext(ctx context.Context, value T) error {
+	return b.push(ctx, value, true, true)
+}
+
+// PushFrontContext inserts new value at front of buffer according to overflow policy.
+// It returns ErrFull if value is rejected, ErrClosed if buffer is closed
+// or ctx.Err() if ctx is done while blocking.
+func (b *circularBuffer[T]) PushFrontContext(ctx context.Context, value T) error {
+	return b.push(ctx, value, false, true)
+}
+
+// Close closes buffer, so that pushing fails and blocked pushes return.
+// Elements can still be popped. Closing closed buffer has no effect.
+func (b *circularBuffer[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.closed {
+		b.closed = true
+		b.notFull.notify()
+	}
+}
+
+// push inserts new value at back or front of buffer according to overflow policy.
+// If block is false, it returns ErrFull instead of blocking.
+func (b *circularBuffer[T]) push(ctx context.Context, value T, back, block bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.makeRoom(ctx, back, block); err != nil {
+		return err
+	}
+
+	if back {
+		b.buf[b.index(b.len)] = value
+	} else {
+		b.head = b.index(len(b.buf) - 1)
+		b.buf[b.head] = value
+	}
+	b.len++
+	return nil
+}
+
+// makeRoom prepares buffer for inserting new value according to overflow policy.
+// If back is true, value will be inserted at back, so the first element is evicted,
+// otherwise the last element is evicted.
+// It returns error if value should be discarded.
+// It must be called with mutex locked.
+func (b *circularBuffer[T]) makeRoom(ctx context.Context, back, block bool) error {
+	for {
+		if b.closed {
+			return ErrClosed
+		}
+		if b.len < len(b.buf) {
+			return nil
+		}
+
+		switch {
+		case b.policy == Reject, b.policy == Block && !block:
+			return ErrFull
+		case b.policy == Block:
+			if err := b.notFull.wait(ctx, &b.mu); err != nil {
+				return err
+			}
+		default:
+			var zero T
+			if back {
+				b.buf[b.head] = zero
+				b.head = b.index(1)
+			} else {
+				b.buf[b.index(b.len-1)] = zero
+			}
+			b.len--
+		}
+	}
+}
+
+// at returns element with index i counting from front of buffer.
+// It must be called with mutex locked.
+func (b *circularBuffer[T]) at(i int) (T, bool) {
+	if i < 0 || i >= b.len {
+		var value T
+		return value, false
+	}
+
+	return b.buf[b.index(i)], true
+}
+
+// index returns buffer index of element with offset i from the first element.
+func (b *circularBuffer[T]) index(i int) int { return (b.head + i) % len(b.buf) }
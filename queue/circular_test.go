@@ -0,0 +1,314 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func emptyCircularBuffer(policy OverflowPolicy) CircularBuffer[int] {
+	return NewCircularBuffer[int](3, policy)
+}
+
+func fullCircularBuffer(policy OverflowPolicy) CircularBuffer[int] {
+	b := NewCircularBuffer[int](3, policy)
+	b.PushBack(2)
+	b.PushBack(3)
+	b.PushFront(1)
+	return b
+}
+
+func TestCircularBuffer_Front(t *testing.T) {
+	tests := []struct {
+		name  string
+		b     CircularBuffer[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyBuffer", emptyCircularBuffer(Overwrite), 0, false},
+		{"FullBuffer", fullCircularBuffer(Overwrite), 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.b.Front()
+			if got != tt.want {
+				t.Errorf("CircularBuffer.Front() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("CircularBuffer.Front() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestCircularBuffer_Back(t *testing.T) {
+	tests := []struct {
+		name  string
+		b     CircularBuffer[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyBuffer", emptyCircularBuffer(Overwrite), 0, false},
+		{"FullBuffer", fullCircularBuffer(Overwrite), 3, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.b.Back()
+			if got != tt.want {
+				t.Errorf("CircularBuffer.Back() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("CircularBuffer.Back() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestCircularBuffer_PopFront(t *testing.T) {
+	tests := []struct {
+		name  string
+		b     CircularBuffer[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyBuffer", emptyCircularBuffer(Overwrite), 0, false},
+		{"FullBuffer", fullCircularBuffer(Overwrite), 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.b.PopFront()
+			if got != tt.want {
+				t.Errorf("CircularBuffer.PopFront() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("CircularBuffer.PopFront() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestCircularBuffer_PopBack(t *testing.T) {
+	tests := []struct {
+		name  string
+		b     CircularBuffer[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyBuffer", emptyCircularBuffer(Overwrite), 0, false},
+		{"FullBuffer", fullCircularBuffer(Overwrite), 3, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.b.PopBack()
+			if got != tt.want {
+				t.Errorf("CircularBuffer.PopBack() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("CircularBuffer.PopBack() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestCircularBuffer_At(t *testing.T) {
+	tests := []struct {
+		name  string
+		b     CircularBuffer[int]
+		i     int
+		want  int
+		want1 bool
+	}{
+		{"EmptyBuffer", emptyCircularBuffer(Overwrite), 0, 0, false},
+		{"First", fullCircularBuffer(Overwrite), 0, 1, true},
+		{"Last", fullCircularBuffer(Overwrite), 2, 3, true},
+		{"Negative", fullCircularBuffer(Overwrite), -1, 0, false},
+		{"OutOfRange", fullCircularBuffer(Overwrite), 3, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.b.At(tt.i)
+			if got != tt.want {
+				t.Errorf("CircularBuffer.At() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("CircularBuffer.At() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestCircularBuffer_Overflow(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy OverflowPolicy
+		op     func(b CircularBuffer[int])
+		want   []int
+	}{
+		{"OverwriteBack", Overwrite, func(b CircularBuffer[int]) { b.PushBack(4); b.PushBack(5) }, []int{3, 4, 5}},
+		{"OverwriteFront", Overwrite, func(b CircularBuffer[int]) { b.PushFront(0); b.PushFront(-1) }, []int{-1, 0, 1}},
+		{"RejectBack", Reject, func(b CircularBuffer[int]) { b.PushBack(4) }, []int{1, 2, 3}},
+		{"RejectFront", Reject, func(b CircularBuffer[int]) { b.PushFront(0) }, []int{1, 2, 3}},
+		{"PopAndPush", Reject, func(b CircularBuffer[int]) { b.PopFront(); b.PushBack(4) }, []int{2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := fullCircularBuffer(tt.policy)
+			tt.op(b)
+			if got := b.Snapshot(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CircularBuffer.Snapshot() = %v, want %v", got, tt.want)
+			}
+			if got := b.Len(); got != b.Cap() {
+				t.Errorf("CircularBuffer.Len() = %v, want %v", got, b.Cap())
+			}
+		})
+	}
+}
+
+// waitBlocked waits until any push into b is blocked.
+func waitBlocked(b CircularBuffer[int]) {
+	c := b.(*circularBuffer[int])
+	for {
+		c.mu.Lock()
+		blocked := c.notFull.ch != nil
+		c.mu.Unlock()
+		if blocked {
+			return
+		}
+		runtime.Gosched()
+	}
+}
+
+func TestCircularBuffer_Block(t *testing.T) {
+	b := fullCircularBuffer(Block)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		b.PushBack(4)
+	}()
+
+	// PushBack must not insert value until element is popped.
+	waitBlocked(b)
+	if got := b.Snapshot(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("CircularBuffer.Snapshot() = %v before PopFront(), want %v", got, []int{1, 2, 3})
+	}
+
+	b.PopFront()
+	wg.Wait()
+	if got := b.Snapshot(); !reflect.DeepEqual(got, []int{2, 3, 4}) {
+		t.Errorf("CircularBuffer.Snapshot() = %v after PopFront(), want %v", got, []int{2, 3, 4})
+	}
+}
+
+func TestCircularBuffer_TryPush(t *testing.T) {
+	closed := emptyCircularBuffer(Overwrite)
+	closed.Close()
+
+	tests := []struct {
+		name  string
+		b     CircularBuffer[int]
+		op    func(b CircularBuffer[int], value int) bool
+		want  bool
+		want1 []int
+	}{
+		{"EmptyBuffer", emptyCircularBuffer(Reject), CircularBuffer[int].TryPushBack, true, []int{4}},
+		{"OverwriteBack", fullCircularBuffer(Overwrite), CircularBuffer[int].TryPushBack, true, []int{2, 3, 4}},
+		{"OverwriteFront", fullCircularBuffer(Overwrite), CircularBuffer[int].TryPushFront, true, []int{4, 1, 2}},
+		{"RejectBack", fullCircularBuffer(Reject), CircularBuffer[int].TryPushBack, false, []int{1, 2, 3}},
+		{"RejectFront", fullCircularBuffer(Reject), CircularBuffer[int].TryPushFront, false, []int{1, 2, 3}},
+		{"Block", fullCircularBuffer(Block), CircularBuffer[int].TryPushBack, false, []int{1, 2, 3}},
+		{"ClosedBuffer", closed, CircularBuffer[int].TryPushBack, false, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.op(tt.b, 4); got != tt.want {
+				t.Errorf("CircularBuffer.TryPush() = %v, want %v", got, tt.want)
+			}
+			if got := tt.b.Snapshot(); !reflect.DeepEqual(got, tt.want1) {
+				t.Errorf("CircularBuffer.Snapshot() = %v, want %v", got, tt.want1)
+			}
+		})
+	}
+}
+
+func TestCircularBuffer_PushContext(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	closed := emptyCircularBuffer(Block)
+	closed.Close()
+
+	tests := []struct {
+		name string
+		b    CircularBuffer[int]
+		ctx  context.Context
+		want error
+	}{
+		{"Overwrite", fullCircularBuffer(Overwrite), context.Background(), nil},
+		{"Reject", fullCircularBuffer(Reject), context.Background(), ErrFull},
+		{"CanceledBlock", fullCircularBuffer(Block), canceled, context.Canceled},
+		{"FreeRoom", emptyCircularBuffer(Block), canceled, nil},
+		{"ClosedBuffer", closed, context.Background(), ErrClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.b.PushBackContext(tt.ctx, 4); !errors.Is(err, tt.want) {
+				t.Errorf("CircularBuffer.PushBackContext() error = %v, want %v", err, tt.want)
+			}
+			if err := tt.b.PushFrontContext(tt.ctx, 0); !errors.Is(err, tt.want) {
+				t.Errorf("CircularBuffer.PushFrontContext() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCircularBuffer_Close(t *testing.T) {
+	b := fullCircularBuffer(Block)
+
+	errs := make(chan error, 1)
+	go func() { errs <- b.PushBackContext(context.Background(), 4) }()
+	waitBlocked(b)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		b.PushFront(0)
+	}()
+
+	// Blocked pushes return after buffer is closed without inserting values.
+	b.Close()
+	wg.Wait()
+	if err := <-errs; !errors.Is(err, ErrClosed) {
+		t.Errorf("CircularBuffer.PushBackContext() error = %v, want %v", err, ErrClosed)
+	}
+	if got := b.Snapshot(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("CircularBuffer.Snapshot() = %v after Close(), want %v", got, []int{1, 2, 3})
+	}
+
+	// Elements can be popped from closed buffer.
+	if got, ok := b.PopFront(); got != 1 || !ok {
+		t.Errorf("CircularBuffer.PopFront() = (%v, %v) after Close(), want (%v, %v)", got, ok, 1, true)
+	}
+	b.Close()
+}
+
+func TestCircularBuffer_Snapshot(t *testing.T) {
+	tests := []struct {
+		name string
+		b    CircularBuffer[int]
+		want []int
+	}{
+		{"EmptyBuffer", emptyCircularBuffer(Overwrite), []int{}},
+		{"FullBuffer", fullCircularBuffer(Overwrite), []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.b.Snapshot(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CircularBuffer.Snapshot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
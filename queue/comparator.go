@@ -0,0 +1,152 @@
+package queue
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// FuncPriorityQueue represents abstract priority queue
+// in which elements are ordered by comparison function.
+type FuncPriorityQueue[T any] interface {
+	// Len returns number of elements contained in queue.
+	Len() int
+	// Front returns the highest-priority element.
+	// If queue is empty, it returns default value of type T and false as second value.
+	Front() (T, bool)
+	// PopFront removes the highest-priority element and returns it.
+	// If queue is empty, it returns default value of type T and false as second value.
+	PopFront() (T, bool)
+	// Push inserts new value into queue.
+	// It returns the inserted value.
+	Push(value T) T
+}
+
+// funcPriorityQueue implements priority queue based on heap ordered by comparison function.
+type funcPriorityQueue[T any] struct {
+	data *funcPrioritySlice[T]
+}
+
+// NewFuncPriorityQueue returns new priority queue based on heap ordered by cmp.
+// cmp should return 0 if a is equal b, a negative number if a precedes b,
+// or a positive number if a follows b. Preceding element has higher priority.
+// Order of equal elements is unspecified.
+func NewFuncPriorityQueue[T any](cmp func(a, b T) int) FuncPriorityQueue[T] {
+	q := newFuncPriorityQueue(cmp, false)
+	return &q
+}
+
+// NewStableFuncPriorityQueue returns new priority queue based on heap ordered by cmp.
+// cmp should return 0 if a is equal b, a negative number if a precedes b,
+// or a positive number if a follows b. Preceding element has higher priority.
+// Equal elements are popped in order of insertion.
+func NewStableFuncPriorityQueue[T any](cmp func(a, b T) int) FuncPriorityQueue[T] {
+	q := newFuncPriorityQueue(cmp, true)
+	return &q
+}
+
+// newFuncPriorityQueue returns new priority queue based on heap ordered by cmp.
+// If stable is true, equal elements are popped in order of insertion.
+func newFuncPriorityQueue[T any](cmp func(a, b T) int, stable bool) funcPriorityQueue[T] {
+	return funcPriorityQueue[T]{&funcPrioritySlice[T]{cmp: cmp, stable: stable}}
+}
+
+// Len returns number of elements contained in queue, O(1).
+func (p funcPriorityQueue[T]) Len() int { return p.data.Len() }
+
+// Front returns element of queue that has the highest priority in heap, O(1).
+// If queue is empty, it returns default value of type T and false as second value.
+func (p funcPriorityQueue[T]) Front() (T, bool) {
+	if p.data.Len() > 0 {
+		return p.data.items[0].value, true
+	}
+
+	var value T
+	return value, false
+}
+
+// PopFront removes element of queue that has the highest priority in heap, O(log(n)).
+// If queue is empty, it returns default value of type T and false as second value.
+func (p *funcPriorityQueue[T]) PopFront() (T, bool) {
+	if p.data.Len() > 0 {
+		return heap.Pop(p.data).(funcPriorityItem[T]).value, true
+	}
+
+	var value T
+	return value, false
+}
+
+// Push inserts new value into queue, O(log(n)).
+// It returns the inserted value.
+func (p *funcPriorityQueue[T]) Push(value T) T {
+	heap.Push(p.data, funcPriorityItem[T]{value: value, seq: p.data.seq})
+	p.data.seq++
+	return value
+}
+
+// funcPriorityItem implements a heap item ordered by comparison function.
+type funcPriorityItem[T any] struct {
+	value T
+	seq   uint64 // insertion sequence number
+}
+
+// funcPrioritySlice implements heap based on slice ordered by comparison function.
+// It implements heap.Interface to use container/heap operations.
+type funcPrioritySlice[T any] struct {
+	items  []funcPriorityItem[T]
+	cmp    func(a, b T) int
+	stable bool
+	seq    uint64 // sequence number of the next inserted item
+}
+
+// Len returns number of items contained in priority slice.
+func (h funcPrioritySlice[T]) Len() int { return len(h.items) }
+
+// Less returns true, if item with index i precedes item with index j.
+// If slice is stable, equal items are ordered by insertion sequence number.
+func (h funcPrioritySlice[T]) Less(i, j int) bool {
+	c := h.cmp(h.items[i].value, h.items[j].value)
+	if c == 0 && h.stable {
+		return h.items[i].seq < h.items[j].seq
+	}
+	return c < 0
+}
+
+// Swap swaps items with indexes i and j.
+func (h funcPrioritySlice[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+// Push inserts new item x at end of slice.
+func (h *funcPrioritySlice[T]) Push(x any) { h.items = append(h.items, x.(funcPriorityItem[T])) }
+
+// Pop removes last item from priority slice and returns it.
+func (h *funcPrioritySlice[T]) Pop() any {
+	i := len(h.items) - 1
+	item := h.items[i]
+	h.items[i] = funcPriorityItem[T]{}
+	h.items = h.items[:i]
+	return item
+}
+
+// clone returns new priority slice with the same items.
+func (h funcPrioritySlice[T]) clone() *funcPrioritySlice[T] {
+	h.items = slices.Clone(h.items)
+	return &h
+}
+
+// clear removes all items from priority slice.
+func (h *funcPrioritySlice[T]) clear() {
+	clear(h.items) // avoid memory leaks
+	h.items = h.items[:0]
+}
+
+// merge moves all items of other priority slice to slice and restores heap, O(n+m).
+// Sequence numbers of moved items are shifted, so that they follow items of slice
+// and keep their relative order.
+func (h *funcPrioritySlice[T]) merge(other *funcPrioritySlice[T]) {
+	for _, item := range other.items {
+		item.seq += h.seq
+		h.items = append(h.items, item)
+	}
+	h.seq += other.seq
+	other.clear()
+	heap.Init(h)
+}
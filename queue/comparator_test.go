@@ -0,0 +1,164 @@
+package queue
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// job represents item ordered by composite key (deadline, tenant).
+type job struct {
+	deadline int
+	tenant   string
+	id       int
+}
+
+func cmpJob(a, b job) int {
+	if c := cmp.Compare(a.deadline, b.deadline); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.tenant, b.tenant)
+}
+
+func emptyFuncPriorityQueue() FuncPriorityQueue[int] { return NewFuncPriorityQueue(cmp.Compare[int]) }
+
+func simpleFuncPriorityQueue() FuncPriorityQueue[int] {
+	pq := NewFuncPriorityQueue(cmp.Compare[int])
+	pq.Push(2)
+	pq.Push(3)
+	pq.Push(1)
+	return pq
+}
+
+func TestFuncPriorityQueue_Len(t *testing.T) {
+	tests := []struct {
+		name string
+		p    FuncPriorityQueue[int]
+		want int
+	}{
+		{"EmptyQueue", emptyFuncPriorityQueue(), 0},
+		{"SimpleQueue", simpleFuncPriorityQueue(), 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Len(); got != tt.want {
+				t.Errorf("FuncPriorityQueue.Len() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFuncPriorityQueue_Front(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     FuncPriorityQueue[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyFuncPriorityQueue(), 0, false},
+		{"SimpleQueue", simpleFuncPriorityQueue(), 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.p.Front()
+			if got != tt.want {
+				t.Errorf("FuncPriorityQueue.Front() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("FuncPriorityQueue.Front() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestFuncPriorityQueue_PopFront(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     FuncPriorityQueue[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyFuncPriorityQueue(), 0, false},
+		{"SimpleQueue", simpleFuncPriorityQueue(), 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.p.PopFront()
+			if got != tt.want {
+				t.Errorf("FuncPriorityQueue.PopFront() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("FuncPriorityQueue.PopFront() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestFuncPriorityQueue_Push(t *testing.T) {
+	type args struct {
+		value int
+	}
+	tests := []struct {
+		name string
+		p    FuncPriorityQueue[int]
+		args args
+		want int
+	}{
+		{"EmptyQueue", emptyFuncPriorityQueue(), args{4}, 4},
+		{"SimpleQueue", simpleFuncPriorityQueue(), args{4}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Push(tt.args.value); got != tt.want {
+				t.Errorf("FuncPriorityQueue.Push() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFuncPriorityQueue_CompositeKey(t *testing.T) {
+	jobs := []job{{3, "b", 0}, {1, "b", 1}, {3, "a", 2}, {2, "a", 3}, {1, "a", 4}}
+	want := []int{4, 1, 3, 2, 0}
+
+	pq := NewFuncPriorityQueue(cmpJob)
+	for _, j := range jobs {
+		pq.Push(j)
+	}
+
+	got := make([]int, 0, len(jobs))
+	for pq.Len() > 0 {
+		j, _ := pq.PopFront()
+		got = append(got, j.id)
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("popped jobs = %v, want %v", got, want)
+	}
+}
+
+func TestStableFuncPriorityQueue(t *testing.T) {
+	const n = 1000
+	jobs := make([]job, n)
+	for i := range jobs {
+		jobs[i] = job{rand.Intn(5), string(rune('a' + rand.Intn(2))), i}
+	}
+
+	want := slices.Clone(jobs)
+	slices.SortStableFunc(want, cmpJob)
+
+	pq := NewStableFuncPriorityQueue(cmpJob)
+	for _, j := range jobs {
+		pq.Push(j)
+	}
+
+	got := make([]job, 0, n)
+	for pq.Len() > 0 {
+		j, _ := pq.PopFront()
+		got = append(got, j)
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("popped jobs = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,136 @@
+package queue
+
+// daryPriorityQueue implements priority queue based on d-ary min/max heap.
+// Element with the highest priority is the root of heap.
+type daryPriorityQueue[T any] struct {
+	data []*PriorityItem[T]
+	d    int
+	max  bool // if true, maximum priority value is the highest priority
+}
+
+// NewMinDaryPriorityQueue returns new priority queue based on d-ary min heap.
+// If d is less than 2, binary heap is used.
+func NewMinDaryPriorityQueue[T any](d int) MergeablePriorityQueue[T] {
+	return &daryPriorityQueue[T]{d: max(d, 2)}
+}
+
+// NewMaxDaryPriorityQueue returns new priority queue based on d-ary max heap.
+// If d is less than 2, binary heap is used.
+func NewMaxDaryPriorityQueue[T any](d int) MergeablePriorityQueue[T] {
+	return &daryPriorityQueue[T]{d: max(d, 2), max: true}
+}
+
+// Len returns number of elements contained in queue, O(1).
+func (p daryPriorityQueue[T]) Len() int { return len(p.data) }
+
+// Front returns element of queue that has the highest priority in heap, O(1).
+// It also returns element's priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p daryPriorityQueue[T]) Front() (T, int, bool) {
+	if len(p.data) > 0 {
+		item := p.data[0]
+		return item.value, item.priority, true
+	}
+
+	var value T
+	return value, 0, false
+}
+
+// PopFront removes element of queue that has the highest priority in heap, O(d*log(n)/log(d)).
+// It returns this element and its priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p *daryPriorityQueue[T]) PopFront() (T, int, bool) {
+	n := len(p.data) - 1
+	if n < 0 {
+		var value T
+		return value, 0, false
+	}
+
+	// Move the last item to the root and restore heap.
+	item := p.data[0]
+	p.data[0] = p.data[n]
+	p.data[n] = nil
+	p.data = p.data[:n]
+	p.down(0)
+
+	return item.value, item.priority, true
+}
+
+// Push inserts new value with priority into queue, O(log(n)/log(d)).
+// It returns the inserted value and its priority.
+func (p *daryPriorityQueue[T]) Push(value T, priority int) (T, int) {
+	p.data = append(p.data, &PriorityItem[T]{value: value, priority: priority})
+	p.up(len(p.data) - 1)
+	return value, priority
+}
+
+// Merge moves all elements of other into queue.
+// If other is d-ary heap with the same order, items are appended and heap is rebuilt in O(n+m),
+// otherwise elements are popped from other and pushed into queue one by one.
+// other becomes empty after merging.
+func (p *daryPriorityQueue[T]) Merge(other PriorityQueue[T]) {
+	o, ok := other.(*daryPriorityQueue[T])
+	if !ok || o.max != p.max {
+		mergeByPopping(p, other)
+		return
+	}
+	if o == p {
+		return
+	}
+
+	p.data = append(p.data, o.data...)
+	o.data = nil
+
+	// Restore heap from the last parent to the root.
+	for i := (len(p.data) - 2) / p.d; i >= 0; i-- {
+		p.down(i)
+	}
+}
+
+// up moves item with index i towards the root while it has higher priority than its parent.
+func (p *daryPriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / p.d
+		if !higher(p.max, p.data[i].priority, p.data[parent].priority) {
+			break
+		}
+
+		p.data[i], p.data[parent] = p.data[parent], p.data[i]
+		i = parent
+	}
+}
+
+// down moves item with index i towards the leaves while any of its children has higher priority.
+func (p *daryPriorityQueue[T]) down(i int) {
+	n := len(p.data)
+	for {
+		// Search for child with the highest priority.
+		first := p.d*i + 1
+		if first >= n {
+			break
+		}
+
+		j := first
+		for k := first + 1; k < first+p.d && k < n; k++ {
+			if higher(p.max, p.data[k].priority, p.data[j].priority) {
+				j = k
+			}
+		}
+
+		if !higher(p.max, p.data[j].priority, p.data[i].priority) {
+			break
+		}
+
+		p.data[i], p.data[j] = p.data[j], p.data[i]
+		i = j
+	}
+}
+
+// higher returns true if priority a is higher than priority b.
+// If isMax is true, greater priority value is higher, otherwise less priority value is higher.
+func higher(isMax bool, a, b int) bool {
+	if isMax {
+		return a > b
+	}
+	return a < b
+}
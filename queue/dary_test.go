@@ -0,0 +1,32 @@
+package queue
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestDaryPriorityQueue(t *testing.T) {
+	for _, d := range []int{0, 2, 3, 4, 8} {
+		t.Run(strconv.Itoa(d), func(t *testing.T) {
+			testPriorityQueue(t,
+				func() PriorityQueue[int] { return NewMinDaryPriorityQueue[int](d) },
+				func() PriorityQueue[int] { return NewMaxDaryPriorityQueue[int](d) },
+			)
+		})
+	}
+}
+
+func BenchmarkDaryPriorityQueue(b *testing.B) {
+	for _, d := range []int{2, 4, 8} {
+		b.Run(strconv.Itoa(d), func(b *testing.B) {
+			benchmarkPriorityQueue(b, func() PriorityQueue[int] { return NewMinDaryPriorityQueue[int](d) })
+		})
+	}
+}
+
+func TestDaryPriorityQueue_Merge(t *testing.T) {
+	testMergeablePriorityQueue(t,
+		func() MergeablePriorityQueue[int] { return NewMinDaryPriorityQueue[int](4) },
+		func() MergeablePriorityQueue[int] { return NewMaxDaryPriorityQueue[int](4) },
+	)
+}
@@ -0,0 +1,76 @@
+package queue
+
+import "time"
+
+// DelayQueue represents abstract queue
+// in which elements can be popped only after their deadlines.
+type DelayQueue[T any] interface {
+	// Len returns number of elements contained in queue, including not expired ones.
+	Len() int
+	// Front returns element with the earliest deadline and its deadline.
+	// If queue is empty, it returns default value of type T and false as third value.
+	Front() (T, time.Time, bool)
+	// PopFront removes element with the earliest deadline and returns it,
+	// if its deadline is not after current time.
+	// Otherwise, it returns default value of type T and false as second value.
+	PopFront() (T, bool)
+	// Push inserts new value with deadline into queue.
+	// It returns the inserted value.
+	Push(value T, deadline time.Time) T
+}
+
+// delayItem represents element of delay queue with its deadline.
+type delayItem[T any] struct {
+	value    T
+	deadline time.Time
+}
+
+// compareDeadlines returns result of comparison of deadlines of a and b.
+func compareDeadlines[T any](a, b delayItem[T]) int { return a.deadline.Compare(b.deadline) }
+
+// delayQueue implements delay queue based on priority queue ordered by deadlines.
+// Deadlines are compared by time.Time.Compare, so any time can be used as deadline.
+type delayQueue[T any] struct {
+	data FuncPriorityQueue[delayItem[T]]
+	now  func() time.Time
+}
+
+// NewDelayQueue returns new delay queue that uses now to get current time.
+// If now is nil, it uses time.Now.
+func NewDelayQueue[T any](now func() time.Time) DelayQueue[T] {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &delayQueue[T]{NewFuncPriorityQueue(compareDeadlines[T]), now}
+}
+
+// Len returns number of elements contained in queue, O(1).
+func (q delayQueue[T]) Len() int { return q.data.Len() }
+
+// Front returns element with the earliest deadline and its deadline, O(1).
+// If queue is empty, it returns default value of type T and false as third value.
+func (q delayQueue[T]) Front() (T, time.Time, bool) {
+	item, ok := q.data.Front()
+	return item.value, item.deadline, ok
+}
+
+// PopFront removes element with the earliest deadline and returns it, O(log(n)).
+// If queue is empty or deadline of element is after current time,
+// it returns default value of type T and false as second value.
+func (q *delayQueue[T]) PopFront() (T, bool) {
+	_, deadline, ok := q.Front()
+	if !ok || deadline.After(q.now()) {
+		var value T
+		return value, false
+	}
+
+	item, _ := q.data.PopFront()
+	return item.value, true
+}
+
+// Push inserts new value with deadline into queue, O(log(n)).
+// It returns the inserted value.
+func (q *delayQueue[T]) Push(value T, deadline time.Time) T {
+	return q.data.Push(delayItem[T]{value, deadline}).value
+}
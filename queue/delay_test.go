@@ -0,0 +1,140 @@
+package queue
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock implements clock whose time is changed manually.
+type fakeClock struct{ t time.Time }
+
+// Now returns current time of clock.
+func (c *fakeClock) Now() time.Time { return c.t }
+
+// Sleep advances clock by d.
+func (c *fakeClock) Sleep(d time.Duration) { c.t = c.t.Add(d) }
+
+func newFakeClock() *fakeClock { return &fakeClock{time.Unix(1000, 0)} }
+
+func TestDelayQueue_Front(t *testing.T) {
+	clock := newFakeClock()
+	tests := []struct {
+		name  string
+		q     DelayQueue[int]
+		want  int
+		want1 time.Time
+		want2 bool
+	}{
+		{"EmptyQueue", NewDelayQueue[int](clock.Now), 0, time.Time{}, false},
+		{"SimpleQueue", func() DelayQueue[int] {
+			q := NewDelayQueue[int](clock.Now)
+			q.Push(2, clock.Now().Add(2*time.Second))
+			q.Push(1, clock.Now().Add(time.Second))
+			return q
+		}(), 1, clock.Now().Add(time.Second), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2 := tt.q.Front()
+			if got != tt.want {
+				t.Errorf("DelayQueue.Front() got = %v, want %v", got, tt.want)
+			}
+			if !got1.Equal(tt.want1) {
+				t.Errorf("DelayQueue.Front() got1 = %v, want %v", got1, tt.want1)
+			}
+			if got2 != tt.want2 {
+				t.Errorf("DelayQueue.Front() got2 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+}
+
+func TestDelayQueue_PopFront(t *testing.T) {
+	clock := newFakeClock()
+	q := NewDelayQueue[int](clock.Now)
+	q.Push(3, clock.Now().Add(3*time.Second))
+	q.Push(1, clock.Now().Add(time.Second))
+	q.Push(2, clock.Now().Add(time.Second))
+	q.Push(0, clock.Now().Add(-time.Second))
+
+	tests := []struct {
+		name  string
+		sleep time.Duration
+		want  []int
+	}{
+		{"Expired", 0, []int{0}},
+		{"NotExpired", 999 * time.Millisecond, nil},
+		{"Deadline", time.Millisecond, []int{1, 2}},
+		{"AfterDeadline", 5 * time.Second, []int{3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock.Sleep(tt.sleep)
+			var got []int
+			for {
+				value, ok := q.PopFront()
+				if !ok {
+					break
+				}
+				got = append(got, value)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("DelayQueue.PopFront() got %v, want %v", got, tt.want)
+			}
+			seen := make(map[int]bool)
+			for _, value := range got {
+				seen[value] = true
+			}
+			for _, value := range tt.want {
+				if !seen[value] {
+					t.Errorf("DelayQueue.PopFront() got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	if got := q.Len(); got != 0 {
+		t.Errorf("DelayQueue.Len() = %v, want %v", got, 0)
+	}
+}
+
+func TestDelayQueue_Deadlines(t *testing.T) {
+	clock := newFakeClock()
+	q := NewDelayQueue[int](clock.Now)
+
+	// Deadlines are out of range of int64 nanoseconds since the Unix epoch.
+	farFuture := time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+	farPast := time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC)
+	q.Push(3, farFuture)
+	q.Push(2, clock.Now())
+	q.Push(0, time.Time{})
+	q.Push(1, farPast)
+
+	tests := []struct {
+		name  string
+		want  int
+		want1 time.Time
+		want2 bool
+	}{
+		{"ZeroTime", 0, time.Time{}, true},
+		{"FarPast", 1, farPast, true},
+		{"Now", 2, clock.Now(), true},
+		{"FarFuture", 3, farFuture, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, _ := q.Front()
+			if got != tt.want || !got1.Equal(tt.want1) {
+				t.Errorf("DelayQueue.Front() = %v, %v, want %v, %v", got, got1, tt.want, tt.want1)
+			}
+			if _, got2 := q.PopFront(); got2 != tt.want2 {
+				t.Errorf("DelayQueue.PopFront() got1 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+
+	if got := q.Len(); got != 1 {
+		t.Errorf("DelayQueue.Len() = %v, want %v", got, 1)
+	}
+}
@@ -13,11 +13,20 @@ type Deque[T any] interface {
 	PushFront(value T) T
 }
 
+// IterableDeque represents abstract double-ended queue that supports iteration and bulk operations.
+type IterableDeque[T any] interface {
+	Deque[T]
+	IterableQueue[T]
+	// PushFrontAll inserts values at front of queue,
+	// so that they are contained in specified order.
+	PushFrontAll(values ...T)
+}
+
 // listDeque implements double-ended queue based on linked list.
 type listDeque[T any] struct{ *listQueue[T] }
 
 // NewListDeque returns new deque based on linked list.
-func NewListDeque[T any]() Deque[T] {
+func NewListDeque[T any]() IterableDeque[T] {
 	return &listDeque[T]{&listQueue[T]{new(list.CircularLinkedList[T])}}
 }
 
@@ -28,3 +37,11 @@ func (d *listDeque[T]) PopBack() (T, bool) { return nodeValue(d.data.PopBack())
 // PushFront inserts new value at front of queue, O(1).
 // It returns the inserted value.
 func (d *listDeque[T]) PushFront(value T) T { return d.data.PushFront(value).Value }
+
+// PushFrontAll inserts values at front of queue, O(k).
+// Values are contained in queue in specified order, so values[0] becomes the first element.
+func (d *listDeque[T]) PushFrontAll(values ...T) {
+	for i := len(values) - 1; i >= 0; i-- {
+		d.data.PushFront(values[i])
+	}
+}
@@ -73,3 +73,37 @@ func TestDeque_PushFront(t *testing.T) {
 		})
 	}
 }
+
+func TestDeque_PushFrontAll(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []int
+	}{
+		{"NoValues", nil, []int{1, 2}},
+		{"SingleValue", []int{0}, []int{0, 1, 2}},
+		{"Values", []int{-1, 0}, []int{-1, 0, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewListDeque[int]()
+			d.PushBackAll(1, 2)
+			d.PushFrontAll(tt.values...)
+			if got := d.ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Deque.ToSlice() = %v after PushFrontAll(), want %v", got, tt.want)
+			}
+			if got := collectRange(d.RangeBack, len(tt.want)); !reflect.DeepEqual(got, reversed(tt.want)) {
+				t.Errorf("Deque.RangeBack() = %v after PushFrontAll(), want %v", got, reversed(tt.want))
+			}
+		})
+	}
+}
+
+// reversed returns reversed copy of slice.
+func reversed(s []int) []int {
+	r := make([]int, len(s))
+	for i, v := range s {
+		r[len(s)-1-i] = v
+	}
+	return r
+}
@@ -0,0 +1,382 @@
+package queue
+
+// fibonacciNode implements a Fibonacci heap node.
+// Siblings are linked into circular doubly linked list.
+type fibonacciNode[T any] struct {
+	value    T
+	priority int
+	degree   int               // number of children
+	mark     bool              // if true, node has lost a child since it became child of its parent
+	parent   *fibonacciNode[T] // parent or nil if node is root
+	child    *fibonacciNode[T] // any child
+	prev     *fibonacciNode[T] // previous sibling
+	next     *fibonacciNode[T] // next sibling
+}
+
+// fibonacciPriorityQueue implements priority queue based on Fibonacci min/max heap.
+// Element with the highest priority is the root pointed by front.
+type fibonacciPriorityQueue[T any] struct {
+	front *fibonacciNode[T] // root with the highest priority
+	len   int
+	max   bool // if true, maximum priority value is the highest priority
+}
+
+// NewMinFibonacciPriorityQueue returns new priority queue based on Fibonacci min heap.
+func NewMinFibonacciPriorityQueue[T any]() MergeablePriorityQueue[T] {
+	return new(fibonacciPriorityQueue[T])
+}
+
+// NewMaxFibonacciPriorityQueue returns new priority queue based on Fibonacci max heap.
+func NewMaxFibonacciPriorityQueue[T any]() MergeablePriorityQueue[T] {
+	return &fibonacciPriorityQueue[T]{max: true}
+}
+
+// Len returns number of elements contained in queue, O(1).
+func (p fibonacciPriorityQueue[T]) Len() int { return p.len }
+
+// Front returns element of queue that has the highest priority in heap, O(1).
+// It also returns element's priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p fibonacciPriorityQueue[T]) Front() (T, int, bool) {
+	if p.front != nil {
+		return p.front.value, p.front.priority, true
+	}
+
+	var value T
+	return value, 0, false
+}
+
+// PopFront removes element of queue that has the highest priority in heap, amortized O(log(n)).
+// It returns this element and its priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p *fibonacciPriorityQueue[T]) PopFront() (T, int, bool) {
+	front := p.front
+	if front == nil {
+		var value T
+		return value, 0, false
+	}
+
+	// Move children of front to root list and remove front from it.
+	roots := front.next
+	if roots == front {
+		roots = nil
+	}
+	unlinkFibonacci(front)
+	roots = spliceFibonacci(roots, front.child)
+	front.child = nil
+
+	p.front = p.consolidate(roots)
+	p.len--
+	return front.value, front.priority, true
+}
+
+// Push inserts new value with priority into queue, O(1).
+// It returns the inserted value and its priority.
+func (p *fibonacciPriorityQueue[T]) Push(value T, priority int) (T, int) {
+	p.push(value, priority)
+	return value, priority
+}
+
+// push inserts new value with priority into queue and returns its node.
+func (p *fibonacciPriorityQueue[T]) push(value T, priority int) *fibonacciNode[T] {
+	node := &fibonacciNode[T]{value: value, priority: priority}
+	node.prev = node
+	node.next = node
+
+	p.front = p.link(p.front, node)
+	p.len++
+	return node
+}
+
+// increase changes priority of node to not lower priority, amortized O(1).
+// If node has higher priority than its parent, it is cut to root list
+// and its ancestors are cut while they are marked.
+func (p *fibonacciPriorityQueue[T]) increase(node *fibonacciNode[T], priority int) {
+	node.priority = priority
+	if parent := node.parent; parent != nil && higher(p.max, priority, parent.priority) {
+		p.cut(node)
+		p.cascadingCut(parent)
+	} else if higher(p.max, priority, p.front.priority) {
+		p.front = node
+	}
+}
+
+// remove removes node from queue, amortized O(log(n)).
+// Node is cut to root list and removed as the front.
+func (p *fibonacciPriorityQueue[T]) remove(node *fibonacciNode[T]) {
+	if parent := node.parent; parent != nil {
+		p.cut(node)
+		p.cascadingCut(parent)
+	}
+
+	p.front = node
+	p.PopFront()
+}
+
+// cut moves node with its subtree from children of its parent to root list.
+func (p *fibonacciPriorityQueue[T]) cut(node *fibonacciNode[T]) {
+	parent := node.parent
+	if node.next == node {
+		parent.child = nil
+	} else {
+		if parent.child == node {
+			parent.child = node.next
+		}
+		unlinkFibonacci(node)
+	}
+	parent.degree--
+
+	node.parent = nil
+	node.mark = false
+	p.front = p.link(p.front, node)
+}
+
+// cascadingCut marks node that has lost a child.
+// If node is already marked, it is cut too and its parent is processed the same way.
+func (p *fibonacciPriorityQueue[T]) cascadingCut(node *fibonacciNode[T]) {
+	for node.parent != nil {
+		if !node.mark {
+			node.mark = true
+			return
+		}
+
+		parent := node.parent
+		p.cut(node)
+		node = parent
+	}
+}
+
+// Merge moves all elements of other into queue.
+// If other is Fibonacci heap with the same order, complexity is O(1),
+// otherwise elements are popped from other and pushed into queue one by one.
+// other becomes empty after merging.
+func (p *fibonacciPriorityQueue[T]) Merge(other PriorityQueue[T]) {
+	if o, ok := other.(*fibonacciPriorityQueue[T]); ok && o.max == p.max {
+		if o != p {
+			p.front = p.link(p.front, o.front)
+			p.len += o.len
+			o.front = nil
+			o.len = 0
+		}
+		return
+	}
+
+	mergeByPopping(p, other)
+}
+
+// link splices two root lists and returns root with the highest priority.
+func (p *fibonacciPriorityQueue[T]) link(a, b *fibonacciNode[T]) *fibonacciNode[T] {
+	roots := spliceFibonacci(a, b)
+	if a != nil && b != nil && higher(p.max, b.priority, a.priority) {
+		return b
+	}
+	return roots
+}
+
+// consolidate links roots of equal degree until all roots have distinct degrees, O(log(n)).
+// It returns root with the highest priority.
+func (p *fibonacciPriorityQueue[T]) consolidate(roots *fibonacciNode[T]) *fibonacciNode[T] {
+	if roots == nil {
+		return nil
+	}
+
+	// Detach roots into slice, because root list is modified while linking.
+	var nodes []*fibonacciNode[T]
+	for node := roots; ; {
+		next := node.next
+		node.prev = node
+		node.next = node
+		node.parent = nil
+		nodes = append(nodes, node)
+		if node = next; node == roots {
+			break
+		}
+	}
+
+	// degrees[d] is root of degree d.
+	var degrees []*fibonacciNode[T]
+	for _, node := range nodes {
+		for {
+			for node.degree >= len(degrees) {
+				degrees = append(degrees, nil)
+			}
+
+			other := degrees[node.degree]
+			if other == nil {
+				degrees[node.degree] = node
+				break
+			}
+			degrees[node.degree] = nil
+
+			// Root with lower priority becomes child of the other root.
+			if higher(p.max, other.priority, node.priority) {
+				node, other = other, node
+			}
+			node.child = spliceFibonacci(node.child, other)
+			node.degree++
+			other.parent = node
+			other.mark = false
+		}
+	}
+
+	// Build new root list and search for root with the highest priority.
+	var front *fibonacciNode[T]
+	for _, node := range degrees {
+		if node != nil {
+			front = p.link(front, node)
+		}
+	}
+
+	return front
+}
+
+// spliceFibonacci joins two circular lists of nodes and returns any node of the resulting list.
+func spliceFibonacci[T any](a, b *fibonacciNode[T]) *fibonacciNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	aNext, bPrev := a.next, b.prev
+	a.next = b
+	b.prev = a
+	bPrev.next = aNext
+	aNext.prev = bPrev
+	return a
+}
+
+// unlinkFibonacci removes node from its circular list.
+func unlinkFibonacci[T any](node *fibonacciNode[T]) {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	node.prev = node
+	node.next = node
+}
+
+// indexedFibonacciPriorityQueue implements indexed priority queue based on Fibonacci min/max heap
+// and hash table of heap nodes.
+type indexedFibonacciPriorityQueue[T comparable] struct {
+	fibonacciPriorityQueue[T]
+	nodes map[T]*fibonacciNode[T]
+}
+
+// NewIndexedMinFibonacciPriorityQueue returns new indexed priority queue based on Fibonacci min heap.
+// Update that decreases priority value is amortized O(1), so it suits decrease-key-heavy workloads.
+func NewIndexedMinFibonacciPriorityQueue[T comparable]() IndexedPriorityQueue[T] {
+	return &indexedFibonacciPriorityQueue[T]{nodes: make(map[T]*fibonacciNode[T])}
+}
+
+// NewIndexedMaxFibonacciPriorityQueue returns new indexed priority queue based on Fibonacci max heap.
+// Update that increases priority value is amortized O(1), so it suits increase-key-heavy workloads.
+func NewIndexedMaxFibonacciPriorityQueue[T comparable]() IndexedPriorityQueue[T] {
+	return &indexedFibonacciPriorityQueue[T]{fibonacciPriorityQueue[T]{max: true}, make(map[T]*fibonacciNode[T])}
+}
+
+// PopFront removes element of queue that has the highest priority in heap, amortized O(log(n)).
+// It returns this element and its priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p *indexedFibonacciPriorityQueue[T]) PopFront() (T, int, bool) {
+	value, priority, ok := p.fibonacciPriorityQueue.PopFront()
+	if ok {
+		delete(p.nodes, value)
+	}
+
+	return value, priority, ok
+}
+
+// Push inserts new value with priority into queue, O(1).
+// If value is already contained in queue, its priority is updated.
+// It returns the inserted value and its priority.
+func (p *indexedFibonacciPriorityQueue[T]) Push(value T, priority int) (T, int) {
+	if !p.Update(value, priority) {
+		p.nodes[value] = p.push(value, priority)
+	}
+
+	return value, priority
+}
+
+// Merge moves all elements of other into queue, O(m*log(n+m)).
+// If value is contained in both queues, its priority is taken from other.
+// other becomes empty after merging.
+func (p *indexedFibonacciPriorityQueue[T]) Merge(other PriorityQueue[T]) { mergeByPopping(p, other) }
+
+// Range calls fn for each element and its priority
+// from the highest to the lowest priority until fn returns false, O(n*log(n)).
+// Queue is not modified.
+func (p indexedFibonacciPriorityQueue[T]) Range(fn func(value T, priority int) bool) {
+	rangeIndexed(p.nodes, p.max, func(node *fibonacciNode[T]) int { return node.priority }, fn)
+}
+
+// ToSlice returns new slice with elements of queue
+// from the highest to the lowest priority, O(n*log(n)).
+func (p indexedFibonacciPriorityQueue[T]) ToSlice() []T {
+	s := make([]T, 0, p.len)
+	p.Range(func(value T, _ int) bool {
+		s = append(s, value)
+		return true
+	})
+	return s
+}
+
+// Clear removes all elements from queue, O(n).
+func (p *indexedFibonacciPriorityQueue[T]) Clear() {
+	p.front = nil
+	p.len = 0
+	clear(p.nodes)
+}
+
+// Drain removes the highest-priority elements and calls fn for each of them and its priority
+// until fn returns false or queue becomes empty, amortized O(k*log(n)).
+// Element for which fn returns false is removed too.
+func (p *indexedFibonacciPriorityQueue[T]) Drain(fn func(value T, priority int) bool) {
+	drainIndexed(p, fn)
+}
+
+// Contains returns true if value is contained in queue, O(1).
+func (p indexedFibonacciPriorityQueue[T]) Contains(value T) bool {
+	_, ok := p.nodes[value]
+	return ok
+}
+
+// Priority returns priority of value, O(1).
+// If value is not contained in queue, it returns 0 and false as second value.
+func (p indexedFibonacciPriorityQueue[T]) Priority(value T) (int, bool) {
+	if node, ok := p.nodes[value]; ok {
+		return node.priority, true
+	}
+
+	return 0, false
+}
+
+// Update changes priority of value contained in queue.
+// If new priority is not lower, complexity is amortized O(1), otherwise amortized O(log(n)).
+// If value is not contained in queue, it returns false.
+func (p *indexedFibonacciPriorityQueue[T]) Update(value T, priority int) bool {
+	node, ok := p.nodes[value]
+	if !ok {
+		return false
+	}
+
+	if !higher(p.max, node.priority, priority) {
+		p.increase(node, priority)
+	} else {
+		p.remove(node)
+		p.nodes[value] = p.push(value, priority)
+	}
+	return true
+}
+
+// Remove removes value from queue and returns its priority, amortized O(log(n)).
+// If value is not contained in queue, it returns 0 and false as second value.
+func (p *indexedFibonacciPriorityQueue[T]) Remove(value T) (int, bool) {
+	node, ok := p.nodes[value]
+	if !ok {
+		return 0, false
+	}
+
+	p.remove(node)
+	delete(p.nodes, value)
+	return node.priority, true
+}
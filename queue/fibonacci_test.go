@@ -0,0 +1,26 @@
+package queue
+
+import "testing"
+
+func TestFibonacciPriorityQueue(t *testing.T) {
+	testPriorityQueue(t,
+		func() PriorityQueue[int] { return NewMinFibonacciPriorityQueue[int]() },
+		func() PriorityQueue[int] { return NewMaxFibonacciPriorityQueue[int]() },
+	)
+}
+
+func TestFibonacciPriorityQueue_Merge(t *testing.T) {
+	testMergeablePriorityQueue(t, NewMinFibonacciPriorityQueue[int], NewMaxFibonacciPriorityQueue[int])
+}
+
+func BenchmarkFibonacciPriorityQueue(b *testing.B) {
+	benchmarkPriorityQueue(b, func() PriorityQueue[int] { return NewMinFibonacciPriorityQueue[int]() })
+}
+
+func TestIndexedFibonacciPriorityQueue(t *testing.T) {
+	testPriorityQueue(t,
+		func() PriorityQueue[int] { return NewIndexedMinFibonacciPriorityQueue[int]() },
+		func() PriorityQueue[int] { return NewIndexedMaxFibonacciPriorityQueue[int]() },
+	)
+	testIndexedPriorityQueue(t, NewIndexedMinFibonacciPriorityQueue[int], NewIndexedMaxFibonacciPriorityQueue[int])
+}
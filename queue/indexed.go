@@ -0,0 +1,162 @@
+package queue
+
+import "container/heap"
+
+// IndexedPriorityQueue represents abstract priority queue
+// in which each value is contained at most once and can be accessed by itself.
+// It supports iteration and bulk operations.
+type IndexedPriorityQueue[T comparable] interface {
+	IterablePriorityQueue[T]
+	// Contains returns true if value is contained in queue.
+	Contains(value T) bool
+	// Priority returns priority of value.
+	// If value is not contained in queue, it returns 0 and false as second value.
+	Priority(value T) (int, bool)
+	// Update changes priority of value contained in queue.
+	// If value is not contained in queue, it returns false.
+	Update(value T, priority int) bool
+	// Remove removes value from queue and returns its priority.
+	// If value is not contained in queue, it returns 0 and false as second value.
+	Remove(value T) (int, bool)
+}
+
+// indexedPriorityQueue implements indexed priority queue based on min/max heap
+// and hash table of heap items.
+type indexedPriorityQueue[T comparable] struct {
+	priorityQueue[T]
+	items map[T]*PriorityItem[T]
+}
+
+// NewIndexedMinPriorityQueue returns new indexed priority queue based on min heap.
+func NewIndexedMinPriorityQueue[T comparable]() IndexedPriorityQueue[T] {
+	return &indexedPriorityQueue[T]{priorityQueue[T]{new(minPrioritySlice[T])}, make(map[T]*PriorityItem[T])}
+}
+
+// NewIndexedMaxPriorityQueue returns new indexed priority queue based on max heap.
+func NewIndexedMaxPriorityQueue[T comparable]() IndexedPriorityQueue[T] {
+	return &indexedPriorityQueue[T]{priorityQueue[T]{new(maxPrioritySlice[T])}, make(map[T]*PriorityItem[T])}
+}
+
+// PopFront removes element of queue that has the highest priority in heap, O(log(n)).
+// It returns this element and its priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p *indexedPriorityQueue[T]) PopFront() (T, int, bool) {
+	value, priority, ok := p.priorityQueue.PopFront()
+	if ok {
+		delete(p.items, value)
+	}
+
+	return value, priority, ok
+}
+
+// Merge moves all elements of other into queue, O(m*log(n+m)).
+// If value is contained in both queues, its priority is taken from other.
+// other becomes empty after merging.
+func (p *indexedPriorityQueue[T]) Merge(other PriorityQueue[T]) { mergeByPopping(p, other) }
+
+// Clear removes all elements from queue, O(n).
+func (p *indexedPriorityQueue[T]) Clear() {
+	p.priorityQueue.Clear()
+	clear(p.items)
+}
+
+// Drain removes the highest-priority elements and calls fn for each of them and its priority
+// until fn returns false or queue becomes empty, O(k*log(n)).
+// Element for which fn returns false is removed too.
+func (p *indexedPriorityQueue[T]) Drain(fn func(value T, priority int) bool) {
+	p.priorityQueue.Drain(func(value T, priority int) bool {
+		delete(p.items, value)
+		return fn(value, priority)
+	})
+}
+
+// Push inserts new value with priority into queue, O(log(n)).
+// If value is already contained in queue, its priority is updated.
+// It returns the inserted value and its priority.
+func (p *indexedPriorityQueue[T]) Push(value T, priority int) (T, int) {
+	if p.Update(value, priority) {
+		return value, priority
+	}
+
+	item := &PriorityItem[T]{
+		value:    value,
+		priority: priority,
+	}
+	heap.Push(p.data, item)
+	p.items[value] = item
+	return item.value, priority
+}
+
+// Contains returns true if value is contained in queue, O(1).
+func (p indexedPriorityQueue[T]) Contains(value T) bool {
+	_, ok := p.items[value]
+	return ok
+}
+
+// Priority returns priority of value, O(1).
+// If value is not contained in queue, it returns 0 and false as second value.
+func (p indexedPriorityQueue[T]) Priority(value T) (int, bool) {
+	if item, ok := p.items[value]; ok {
+		return item.priority, true
+	}
+
+	return 0, false
+}
+
+// Update changes priority of value contained in queue, O(log(n)).
+// If value is not contained in queue, it returns false.
+func (p *indexedPriorityQueue[T]) Update(value T, priority int) bool {
+	item, ok := p.items[value]
+	if !ok {
+		return false
+	}
+
+	item.priority = priority
+	heap.Fix(p.data, item.index)
+	return true
+}
+
+// Remove removes value from queue and returns its priority, O(log(n)).
+// If value is not contained in queue, it returns 0 and false as second value.
+func (p *indexedPriorityQueue[T]) Remove(value T) (int, bool) {
+	item, ok := p.items[value]
+	if !ok {
+		return 0, false
+	}
+
+	heap.Remove(p.data, item.index)
+	delete(p.items, value)
+	return item.priority, true
+}
+
+// rangeIndexed calls fn for each value of index and its priority
+// from the highest to the lowest priority until fn returns false, O(n*log(n)).
+// priority returns priority of heap node.
+func rangeIndexed[T comparable, N any](nodes map[T]N, max bool, priority func(node N) int, fn func(value T, priority int) bool) {
+	var data prioritySlice[T] = new(minPrioritySlice[T])
+	if max {
+		data = new(maxPrioritySlice[T])
+	}
+	for value, node := range nodes {
+		data.Push(&PriorityItem[T]{value: value, priority: priority(node)})
+	}
+
+	heap.Init(data)
+	for data.Len() > 0 {
+		item := heap.Pop(data).(*PriorityItem[T])
+		if !fn(item.value, item.priority) {
+			return
+		}
+	}
+}
+
+// drainIndexed pops elements of p and calls fn for each of them and its priority
+// until fn returns false or p becomes empty.
+func drainIndexed[T any](p PriorityQueue[T], fn func(value T, priority int) bool) {
+	for p.Len() > 0 {
+		value, priority, _ := p.PopFront()
+		if !fn(value, priority) {
+			return
+		}
+	}
+}
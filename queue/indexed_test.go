@@ -0,0 +1,409 @@
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func emptyIndexedPriorityQueue() IndexedPriorityQueue[int] { return NewIndexedMinPriorityQueue[int]() }
+
+func simpleIndexedPriorityQueue() IndexedPriorityQueue[int] {
+	pq := NewIndexedMinPriorityQueue[int]()
+	pq.Push(1, 2)
+	pq.Push(2, 3)
+	pq.Push(3, 1)
+	return pq
+}
+
+func TestNewIndexedMinPriorityQueue(t *testing.T) {
+	tests := []struct {
+		name string
+		want IndexedPriorityQueue[int]
+	}{
+		{"EmptyQueue", &indexedPriorityQueue[int]{priorityQueue[int]{new(minPrioritySlice[int])}, make(map[int]*PriorityItem[int])}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewIndexedMinPriorityQueue[int](); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewIndexedMinPriorityQueue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewIndexedMaxPriorityQueue(t *testing.T) {
+	tests := []struct {
+		name string
+		want IndexedPriorityQueue[int]
+	}{
+		{"EmptyQueue", &indexedPriorityQueue[int]{priorityQueue[int]{new(maxPrioritySlice[int])}, make(map[int]*PriorityItem[int])}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewIndexedMaxPriorityQueue[int](); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewIndexedMaxPriorityQueue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexedPriorityQueue_PopFront(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     IndexedPriorityQueue[int]
+		want  int
+		want1 int
+		want2 bool
+	}{
+		{"EmptyQueue", emptyIndexedPriorityQueue(), 0, 0, false},
+		{"SimpleQueue", simpleIndexedPriorityQueue(), 3, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2 := tt.p.PopFront()
+			if got != tt.want {
+				t.Errorf("IndexedPriorityQueue.PopFront() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("IndexedPriorityQueue.PopFront() got1 = %v, want %v", got1, tt.want1)
+			}
+			if got2 != tt.want2 {
+				t.Errorf("IndexedPriorityQueue.PopFront() got2 = %v, want %v", got2, tt.want2)
+			}
+			if tt.p.Contains(got) {
+				t.Errorf("queue contains %v after IndexedPriorityQueue.PopFront()", got)
+			}
+		})
+	}
+}
+
+func TestIndexedPriorityQueue_Push(t *testing.T) {
+	type args struct {
+		value    int
+		priority int
+	}
+	tests := []struct {
+		name  string
+		p     IndexedPriorityQueue[int]
+		args  args
+		want  int
+		want1 int
+	}{
+		{"EmptyQueue", emptyIndexedPriorityQueue(), args{4, 0}, 1, 4},
+		{"NewValue", simpleIndexedPriorityQueue(), args{4, 0}, 4, 4},
+		{"ExistingValue", simpleIndexedPriorityQueue(), args{2, 0}, 3, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.p.Push(tt.args.value, tt.args.priority)
+			if got := tt.p.Len(); got != tt.want {
+				t.Errorf("IndexedPriorityQueue.Len() = %v, want %v", got, tt.want)
+			}
+			if got, _, _ := tt.p.Front(); got != tt.want1 {
+				t.Errorf("IndexedPriorityQueue.Front() = %v, want %v", got, tt.want1)
+			}
+		})
+	}
+}
+
+func TestIndexedPriorityQueue_Contains(t *testing.T) {
+	type args struct {
+		value int
+	}
+	tests := []struct {
+		name string
+		p    IndexedPriorityQueue[int]
+		args args
+		want bool
+	}{
+		{"EmptyQueue", emptyIndexedPriorityQueue(), args{1}, false},
+		{"ExistingValue", simpleIndexedPriorityQueue(), args{1}, true},
+		{"NonExistentValue", simpleIndexedPriorityQueue(), args{4}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Contains(tt.args.value); got != tt.want {
+				t.Errorf("IndexedPriorityQueue.Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexedPriorityQueue_Priority(t *testing.T) {
+	type args struct {
+		value int
+	}
+	tests := []struct {
+		name  string
+		p     IndexedPriorityQueue[int]
+		args  args
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyIndexedPriorityQueue(), args{1}, 0, false},
+		{"ExistingValue", simpleIndexedPriorityQueue(), args{2}, 3, true},
+		{"NonExistentValue", simpleIndexedPriorityQueue(), args{4}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.p.Priority(tt.args.value)
+			if got != tt.want {
+				t.Errorf("IndexedPriorityQueue.Priority() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("IndexedPriorityQueue.Priority() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestIndexedPriorityQueue_Update(t *testing.T) {
+	type args struct {
+		value    int
+		priority int
+	}
+	tests := []struct {
+		name  string
+		p     IndexedPriorityQueue[int]
+		args  args
+		want  bool
+		want1 int
+	}{
+		{"EmptyQueue", emptyIndexedPriorityQueue(), args{1, 0}, false, 0},
+		{"DecreasePriority", simpleIndexedPriorityQueue(), args{2, 0}, true, 2},
+		{"IncreasePriority", simpleIndexedPriorityQueue(), args{3, 4}, true, 1},
+		{"NonExistentValue", simpleIndexedPriorityQueue(), args{4, 0}, false, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Update(tt.args.value, tt.args.priority); got != tt.want {
+				t.Errorf("IndexedPriorityQueue.Update() = %v, want %v", got, tt.want)
+			}
+			if got, _, _ := tt.p.Front(); got != tt.want1 {
+				t.Errorf("IndexedPriorityQueue.Front() = %v, want %v", got, tt.want1)
+			}
+		})
+	}
+}
+
+func TestIndexedPriorityQueue_Remove(t *testing.T) {
+	type args struct {
+		value int
+	}
+	tests := []struct {
+		name  string
+		p     IndexedPriorityQueue[int]
+		args  args
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyIndexedPriorityQueue(), args{1}, 0, false},
+		{"ExistingValue", simpleIndexedPriorityQueue(), args{3}, 1, true},
+		{"NonExistentValue", simpleIndexedPriorityQueue(), args{4}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.p.Remove(tt.args.value)
+			if got != tt.want {
+				t.Errorf("IndexedPriorityQueue.Remove() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("IndexedPriorityQueue.Remove() got1 = %v, want %v", got1, tt.want1)
+			}
+			if tt.p.Contains(tt.args.value) {
+				t.Errorf("queue contains %v after IndexedPriorityQueue.Remove()", tt.args.value)
+			}
+		})
+	}
+}
+
+func FuzzIndexedPriorityQueue(f *testing.F) {
+	for range 100 {
+		b := make([]byte, 100)
+		for i := range b {
+			b[i] = byte(rand.Intn(256))
+		}
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		pq := NewIndexedMinPriorityQueue[int]()
+		priorities := make(map[int]int)
+
+		// Each byte is operation with value and priority.
+		for i, op := range b {
+			value := int(op) % 16
+			switch op % 3 {
+			case 0:
+				pq.Push(value, i)
+				priorities[value] = i
+			case 1:
+				pq.Update(value, -i)
+				if _, ok := priorities[value]; ok {
+					priorities[value] = -i
+				}
+			case 2:
+				pq.Remove(value)
+				delete(priorities, value)
+			}
+		}
+
+		want := make([]int, 0, len(priorities))
+		for _, priority := range priorities {
+			want = append(want, priority)
+		}
+		slices.Sort(want)
+
+		got := make([]int, 0, pq.Len())
+		for pq.Len() > 0 {
+			_, priority, _ := pq.PopFront()
+			got = append(got, priority)
+		}
+
+		if !slices.Equal(got, want) {
+			t.Errorf("popped priorities = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestIndexedPriorityQueue_Drain(t *testing.T) {
+	p := NewIndexedMinPriorityQueue[int]()
+	p.Push(1, 1)
+	p.Push(2, 2)
+	p.Push(3, 3)
+
+	// Promoted iteration methods must keep index consistent.
+	p.Drain(func(value, _ int) bool { return value < 2 })
+	if p.Contains(1) || p.Contains(2) || !p.Contains(3) {
+		t.Errorf("IndexedPriorityQueue.Contains() is inconsistent after Drain()")
+	}
+
+	p.Clear()
+	if p.Contains(3) || p.Len() != 0 {
+		t.Errorf("IndexedPriorityQueue.Contains() is inconsistent after Clear()")
+	}
+}
+
+// testIndexedPriorityQueue checks priority queues created by newMin and newMax
+// against map of priorities with random pushes, updates, removals and pops.
+func testIndexedPriorityQueue(t *testing.T, newMin, newMax func() IndexedPriorityQueue[int]) {
+	tests := []struct {
+		name   string
+		new    func() IndexedPriorityQueue[int]
+		better func(a, b int) bool
+	}{
+		{"MinQueue", newMin, func(a, b int) bool { return a < b }},
+		{"MaxQueue", newMax, func(a, b int) bool { return a > b }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			p := tt.new()
+			want := make(map[int]int) // priorities of values expected to be contained in queue
+
+			for i := range 20000 {
+				value := r.Intn(500)
+				switch op := r.Intn(5); {
+				case op < 2:
+					priority := r.Intn(1000)
+					p.Push(value, priority)
+					want[value] = priority
+				case op == 2:
+					// Updates mostly move priority toward front, as in Dijkstra's algorithm.
+					priority, ok := want[value]
+					delta := r.Intn(100)
+					if r.Intn(4) == 0 {
+						delta = -delta
+					}
+					if tt.better(1, 0) {
+						delta = -delta
+					}
+					priority -= delta
+					if got := p.Update(value, priority); got != ok {
+						t.Fatalf("step %v: IndexedPriorityQueue.Update() = %v, want %v", i, got, ok)
+					}
+					if ok {
+						want[value] = priority
+					}
+				case op == 3:
+					wantPriority, ok := want[value]
+					if got, got1 := p.Remove(value); got != wantPriority || got1 != ok {
+						t.Fatalf("step %v: IndexedPriorityQueue.Remove() = (%v, %v), want (%v, %v)", i, got, got1, wantPriority, ok)
+					}
+					delete(want, value)
+				default:
+					got, priority, ok := p.PopFront()
+					if ok != (len(want) > 0) {
+						t.Fatalf("step %v: IndexedPriorityQueue.PopFront() got2 = %v, want %v", i, ok, len(want) > 0)
+					}
+					if !ok {
+						continue
+					}
+					for _, other := range want {
+						if tt.better(other, priority) {
+							t.Fatalf("step %v: IndexedPriorityQueue.PopFront() got1 = %v, queue contains %v", i, priority, other)
+						}
+					}
+					if wantPriority, ok := want[got]; !ok || wantPriority != priority {
+						t.Fatalf("step %v: IndexedPriorityQueue.PopFront() = (%v, %v), want priority %v", i, got, priority, wantPriority)
+					}
+					delete(want, got)
+				}
+
+				if got := p.Len(); got != len(want) {
+					t.Fatalf("step %v: IndexedPriorityQueue.Len() = %v, want %v", i, got, len(want))
+				}
+			}
+
+			for value, wantPriority := range want {
+				if got, ok := p.Priority(value); !ok || got != wantPriority {
+					t.Fatalf("IndexedPriorityQueue.Priority(%v) = (%v, %v), want (%v, %v)", value, got, ok, wantPriority, true)
+				}
+			}
+
+			// Range visits all elements in priority order without modifying queue.
+			var priorities []int
+			p.Range(func(value, priority int) bool {
+				if want[value] != priority {
+					t.Fatalf("IndexedPriorityQueue.Range() visits (%v, %v), want priority %v", value, priority, want[value])
+				}
+				priorities = append(priorities, priority)
+				return true
+			})
+			if len(priorities) != len(want) || p.Len() != len(want) || len(p.ToSlice()) != len(want) {
+				t.Fatalf("IndexedPriorityQueue.Range() visits %v elements, want %v", len(priorities), len(want))
+			}
+			for i := 1; i < len(priorities); i++ {
+				if tt.better(priorities[i], priorities[i-1]) {
+					t.Fatalf("IndexedPriorityQueue.Range() visits priorities %v out of order", priorities)
+				}
+			}
+
+			// Drain and Clear keep index consistent.
+			n := 0
+			p.Drain(func(value, priority int) bool {
+				if p.Contains(value) || priority != priorities[n] {
+					t.Fatalf("IndexedPriorityQueue.Drain() got (%v, %v), want priority %v removed from queue", value, priority, priorities[n])
+				}
+				n++
+				return n < len(priorities)/2
+			})
+			if got := p.Len(); got != len(priorities)-n {
+				t.Fatalf("IndexedPriorityQueue.Len() = %v after Drain(), want %v", got, len(priorities)-n)
+			}
+			p.Clear()
+			if got, _ := p.Priority(r.Intn(500)); p.Len() != 0 || got != 0 {
+				t.Fatalf("IndexedPriorityQueue is not empty after Clear()")
+			}
+			p.Push(1, 1)
+			if got, _, _ := p.Front(); got != 1 || p.Len() != 1 {
+				t.Fatalf("IndexedPriorityQueue.Front() = %v after Clear() and Push(), want %v", got, 1)
+			}
+		})
+	}
+}
+
+func TestIndexedPriorityQueue_Random(t *testing.T) {
+	testIndexedPriorityQueue(t, NewIndexedMinPriorityQueue[int], NewIndexedMaxPriorityQueue[int])
+}
@@ -0,0 +1,122 @@
+package queue
+
+// leftistNode implements a leftist heap node.
+// Rank of node is length of the shortest path to a missing child.
+type leftistNode[T any] struct {
+	value    T
+	priority int
+	rank     int
+	left     *leftistNode[T]
+	right    *leftistNode[T]
+}
+
+// leftistPriorityQueue implements priority queue based on leftist min/max heap.
+// Element with the highest priority is the root of heap.
+// Rank of left child is never less than rank of right child, so right spine has O(log(n)) nodes.
+type leftistPriorityQueue[T any] struct {
+	root *leftistNode[T]
+	len  int
+	max  bool // if true, maximum priority value is the highest priority
+}
+
+// NewMinLeftistPriorityQueue returns new priority queue based on leftist min heap.
+func NewMinLeftistPriorityQueue[T any]() MergeablePriorityQueue[T] {
+	return new(leftistPriorityQueue[T])
+}
+
+// NewMaxLeftistPriorityQueue returns new priority queue based on leftist max heap.
+func NewMaxLeftistPriorityQueue[T any]() MergeablePriorityQueue[T] {
+	return &leftistPriorityQueue[T]{max: true}
+}
+
+// Len returns number of elements contained in queue, O(1).
+func (p leftistPriorityQueue[T]) Len() int { return p.len }
+
+// Front returns element of queue that has the highest priority in heap, O(1).
+// It also returns element's priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p leftistPriorityQueue[T]) Front() (T, int, bool) {
+	if p.root != nil {
+		return p.root.value, p.root.priority, true
+	}
+
+	var value T
+	return value, 0, false
+}
+
+// PopFront removes element of queue that has the highest priority in heap, O(log(n)).
+// It returns this element and its priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p *leftistPriorityQueue[T]) PopFront() (T, int, bool) {
+	root := p.root
+	if root == nil {
+		var value T
+		return value, 0, false
+	}
+
+	p.root = p.meld(root.left, root.right)
+	p.len--
+
+	// Avoid memory leaks.
+	root.left = nil
+	root.right = nil
+	return root.value, root.priority, true
+}
+
+// Push inserts new value with priority into queue, O(log(n)).
+// It returns the inserted value and its priority.
+func (p *leftistPriorityQueue[T]) Push(value T, priority int) (T, int) {
+	p.root = p.meld(p.root, &leftistNode[T]{value: value, priority: priority, rank: 1})
+	p.len++
+	return value, priority
+}
+
+// Merge moves all elements of other into queue.
+// If other is leftist heap with the same order, complexity is O(log(n+m)),
+// otherwise elements are popped from other and pushed into queue one by one.
+// other becomes empty after merging.
+func (p *leftistPriorityQueue[T]) Merge(other PriorityQueue[T]) {
+	o, ok := other.(*leftistPriorityQueue[T])
+	if !ok || o.max != p.max {
+		mergeByPopping(p, other)
+		return
+	}
+	if o == p {
+		return
+	}
+
+	p.root = p.meld(p.root, o.root)
+	p.len += o.len
+	o.root = nil
+	o.len = 0
+}
+
+// meld merges two heaps along their right spines and returns root of the resulting heap.
+func (p *leftistPriorityQueue[T]) meld(a, b *leftistNode[T]) *leftistNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if higher(p.max, b.priority, a.priority) {
+		a, b = b, a
+	}
+
+	// Merge into right subtree, then swap children to keep the left one higher-ranked.
+	a.right = p.meld(a.right, b)
+	if leftistRank(a.left) < leftistRank(a.right) {
+		a.left, a.right = a.right, a.left
+	}
+	a.rank = leftistRank(a.right) + 1
+	return a
+}
+
+// leftistRank returns rank of node or 0 if node is nil.
+func leftistRank[T any](node *leftistNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.rank
+}
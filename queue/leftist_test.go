@@ -0,0 +1,18 @@
+package queue
+
+import "testing"
+
+func TestLeftistPriorityQueue(t *testing.T) {
+	testPriorityQueue(t,
+		func() PriorityQueue[int] { return NewMinLeftistPriorityQueue[int]() },
+		func() PriorityQueue[int] { return NewMaxLeftistPriorityQueue[int]() },
+	)
+}
+
+func TestLeftistPriorityQueue_Merge(t *testing.T) {
+	testMergeablePriorityQueue(t, NewMinLeftistPriorityQueue[int], NewMaxLeftistPriorityQueue[int])
+}
+
+func BenchmarkLeftistPriorityQueue(b *testing.B) {
+	benchmarkPriorityQueue(b, func() PriorityQueue[int] { return NewMinLeftistPriorityQueue[int]() })
+}
@@ -0,0 +1,134 @@
+package queue
+
+import "sync/atomic"
+
+// lockFreeNode implements a node of lock-free queue.
+// Value is stored by pointer, so that it can be cleared when node becomes dummy
+// without racing with concurrent readers.
+type lockFreeNode[T any] struct {
+	value atomic.Pointer[T] // nil if node is dummy
+	next  atomic.Pointer[lockFreeNode[T]]
+}
+
+// lockFreeQueue implements Michael-Scott lock-free queue based on singly linked list.
+// It is safe for concurrent use by multiple producers and consumers.
+// head points to dummy node, which precedes the first element.
+type lockFreeQueue[T any] struct {
+	head atomic.Pointer[lockFreeNode[T]]
+	tail atomic.Pointer[lockFreeNode[T]]
+	len  atomic.Int64
+}
+
+// NewLockFreeQueue returns new lock-free queue that is safe for concurrent use.
+func NewLockFreeQueue[T any]() Queue[T] {
+	q := new(lockFreeQueue[T])
+	dummy := new(lockFreeNode[T])
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// Len returns number of elements contained in queue, O(1).
+// Under concurrent modification, result is approximate.
+func (q *lockFreeQueue[T]) Len() int { return int(max(q.len.Load(), 0)) }
+
+// Front returns first element of queue, O(1).
+// If queue is empty, it returns default value of type T and false as second value.
+func (q *lockFreeQueue[T]) Front() (T, bool) {
+	for {
+		next := q.head.Load().next.Load()
+		if next == nil {
+			var value T
+			return value, false
+		}
+
+		// If value is cleared, next has been popped concurrently, so try again.
+		if value := next.value.Load(); value != nil {
+			return *value, true
+		}
+	}
+}
+
+// Back returns last element of queue, amortized O(1).
+// If queue is empty, it returns default value of type T and false as second value.
+func (q *lockFreeQueue[T]) Back() (T, bool) {
+	for {
+		head := q.head.Load()
+
+		// Tail may lag behind the last node, so follow links to the end.
+		last := q.tail.Load()
+		for next := last.next.Load(); next != nil; next = last.next.Load() {
+			last = next
+		}
+
+		if last == head {
+			var value T
+			return value, false
+		}
+
+		// If value is cleared, last has been popped concurrently, so try again.
+		if value := last.value.Load(); value != nil {
+			return *value, true
+		}
+	}
+}
+
+// PopFront removes first element from queue and returns it, amortized O(1).
+// If queue is empty, it returns default value of type T and false as second value.
+func (q *lockFreeQueue[T]) PopFront() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+
+		if next == nil {
+			var value T
+			return value, false
+		}
+
+		// If tail lags behind, try to advance it.
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		// Read value before moving head, so that next becomes new dummy node.
+		// If value is cleared, next has been popped concurrently, so CAS fails.
+		value := next.value.Load()
+		if value != nil && q.head.CompareAndSwap(head, next) {
+			next.value.Store(nil) // avoid memory leaks
+			q.len.Add(-1)
+			return *value, true
+		}
+	}
+}
+
+// PushBack inserts new value at back of queue, amortized O(1).
+// It returns the inserted value.
+func (q *lockFreeQueue[T]) PushBack(value T) T {
+	node := new(lockFreeNode[T])
+	node.value.Store(&value)
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+
+		// If tail lags behind, try to advance it.
+		if next != nil {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		// Link node to the last node, then try to advance tail.
+		if tail.next.CompareAndSwap(nil, node) {
+			q.tail.CompareAndSwap(tail, node)
+			q.len.Add(1)
+			return value
+		}
+	}
+}
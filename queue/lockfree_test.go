@@ -0,0 +1,224 @@
+package queue
+
+import (
+	"sync"
+	"testing"
+)
+
+func emptyLockFreeQueue() Queue[int] { return NewLockFreeQueue[int]() }
+
+func simpleLockFreeQueue() Queue[int] {
+	q := NewLockFreeQueue[int]()
+	q.PushBack(1)
+	q.PushBack(2)
+	return q
+}
+
+func TestLockFreeQueue_Len(t *testing.T) {
+	tests := []struct {
+		name string
+		q    Queue[int]
+		want int
+	}{
+		{"EmptyQueue", emptyLockFreeQueue(), 0},
+		{"SimpleQueue", simpleLockFreeQueue(), 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.q.Len(); got != tt.want {
+				t.Errorf("Queue.Len() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockFreeQueue_Front(t *testing.T) {
+	tests := []struct {
+		name  string
+		q     Queue[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyLockFreeQueue(), 0, false},
+		{"SimpleQueue", simpleLockFreeQueue(), 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.q.Front()
+			if got != tt.want {
+				t.Errorf("Queue.Front() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("Queue.Front() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestLockFreeQueue_Back(t *testing.T) {
+	tests := []struct {
+		name  string
+		q     Queue[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyLockFreeQueue(), 0, false},
+		{"SimpleQueue", simpleLockFreeQueue(), 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.q.Back()
+			if got != tt.want {
+				t.Errorf("Queue.Back() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("Queue.Back() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestLockFreeQueue_PopFront(t *testing.T) {
+	tests := []struct {
+		name  string
+		q     Queue[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyLockFreeQueue(), 0, false},
+		{"SimpleQueue", simpleLockFreeQueue(), 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.q.PopFront()
+			if got != tt.want {
+				t.Errorf("Queue.PopFront() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("Queue.PopFront() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+
+	// Popped node becomes dummy node, so its value is cleared.
+	q := simpleLockFreeQueue()
+	q.PopFront()
+	if value := q.(*lockFreeQueue[int]).head.Load().value.Load(); value != nil {
+		t.Errorf("dummy node value = %v after PopFront(), want nil", *value)
+	}
+}
+
+func TestLockFreeQueue_PushBack(t *testing.T) {
+	tests := []struct {
+		name string
+		q    Queue[int]
+		want int
+	}{
+		{"EmptyQueue", emptyLockFreeQueue(), 3},
+		{"SimpleQueue", simpleLockFreeQueue(), 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.q.PushBack(3); got != tt.want {
+				t.Errorf("Queue.PushBack() = %v, want %v", got, tt.want)
+			}
+			if got, _ := tt.q.Back(); got != tt.want {
+				t.Errorf("Queue.Back() = %v after PushBack(), want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockFreeQueue_Concurrent(t *testing.T) {
+	const producers, consumers, n = 4, 4, 10000
+	q := NewLockFreeQueue[int]()
+
+	var wg sync.WaitGroup
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range n {
+				q.PushBack(p*n + i)
+			}
+		}()
+	}
+
+	// Each consumer checks that elements of each producer are popped in order.
+	var mu sync.Mutex
+	popped := make([]bool, producers*n)
+	var done sync.WaitGroup
+	stop := make(chan struct{})
+	for range consumers {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			last := make([]int, producers)
+			for i := range last {
+				last[i] = -1
+			}
+
+			for {
+				value, ok := q.PopFront()
+				if !ok {
+					select {
+					case <-stop:
+						if q.Len() == 0 {
+							return
+						}
+					default:
+					}
+					continue
+				}
+
+				p, i := value/n, value%n
+				if i <= last[p] {
+					t.Errorf("element %v of producer %v popped after element %v", i, p, last[p])
+				}
+				last[p] = i
+
+				mu.Lock()
+				popped[value] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(stop)
+	done.Wait()
+
+	for value, ok := range popped {
+		if !ok {
+			t.Fatalf("element %v is not popped", value)
+		}
+	}
+	if got := q.Len(); got != 0 {
+		t.Errorf("Queue.Len() = %v, want %v", got, 0)
+	}
+}
+
+// benchmarkConcurrentQueue measures pushing and popping elements by parallel goroutines.
+func benchmarkConcurrentQueue(b *testing.B, push func(value int), pop func()) {
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			push(i)
+			pop()
+		}
+	})
+}
+
+func BenchmarkLockFreeQueue(b *testing.B) {
+	q := NewLockFreeQueue[int]()
+	benchmarkConcurrentQueue(b, func(value int) { q.PushBack(value) }, func() { q.PopFront() })
+}
+
+func BenchmarkMutexListQueue(b *testing.B) {
+	q := NewBlockingQueue(NewListQueue[int](), 0)
+	benchmarkConcurrentQueue(b, func(value int) { q.TryPush(value) }, func() { q.TryPop() })
+}
+
+func BenchmarkChannelQueue(b *testing.B) {
+	q := make(chan int, 1024)
+	benchmarkConcurrentQueue(b, func(value int) { q <- value }, func() { <-q })
+}
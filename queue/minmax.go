@@ -0,0 +1,181 @@
+package queue
+
+import "math/bits"
+
+// DoubleEndedPriorityQueue represents abstract priority queue
+// that provides access to both minimum and maximum priority elements.
+type DoubleEndedPriorityQueue[T any] interface {
+	// Len returns number of elements contained in queue.
+	Len() int
+	// PeekMin returns element with minimum priority and its priority.
+	// If queue is empty, it returns default value of type T and false as third value.
+	PeekMin() (T, int, bool)
+	// PeekMax returns element with maximum priority and its priority.
+	// If queue is empty, it returns default value of type T and false as third value.
+	PeekMax() (T, int, bool)
+	// PopMin removes element with minimum priority, returns it and its priority.
+	// If queue is empty, it returns default value of type T and false as third value.
+	PopMin() (T, int, bool)
+	// PopMax removes element with maximum priority, returns it and its priority.
+	// If queue is empty, it returns default value of type T and false as third value.
+	PopMax() (T, int, bool)
+	// Push inserts new value with priority into queue.
+	// It returns the inserted value and its priority.
+	Push(value T, priority int) (T, int)
+}
+
+// minMaxPriorityQueue implements double-ended priority queue based on min-max heap.
+// Items on even levels are less than or equal to their descendants,
+// items on odd levels are greater than or equal to their descendants.
+type minMaxPriorityQueue[T any] struct {
+	data []*PriorityItem[T]
+}
+
+// NewMinMaxPriorityQueue returns new double-ended priority queue based on min-max heap.
+func NewMinMaxPriorityQueue[T any]() DoubleEndedPriorityQueue[T] {
+	return new(minMaxPriorityQueue[T])
+}
+
+// Len returns number of elements contained in queue, O(1).
+func (p minMaxPriorityQueue[T]) Len() int { return len(p.data) }
+
+// PeekMin returns element with minimum priority, O(1).
+// It also returns element's priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p minMaxPriorityQueue[T]) PeekMin() (T, int, bool) { return p.item(p.minIndex()) }
+
+// PeekMax returns element with maximum priority, O(1).
+// It also returns element's priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p minMaxPriorityQueue[T]) PeekMax() (T, int, bool) { return p.item(p.maxIndex()) }
+
+// PopMin removes element with minimum priority, O(log(n)).
+// It returns this element and its priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p *minMaxPriorityQueue[T]) PopMin() (T, int, bool) { return p.remove(p.minIndex()) }
+
+// PopMax removes element with maximum priority, O(log(n)).
+// It returns this element and its priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p *minMaxPriorityQueue[T]) PopMax() (T, int, bool) { return p.remove(p.maxIndex()) }
+
+// Push inserts new value with priority into queue, O(log(n)).
+// It returns the inserted value and its priority.
+func (p *minMaxPriorityQueue[T]) Push(value T, priority int) (T, int) {
+	p.data = append(p.data, &PriorityItem[T]{value: value, priority: priority})
+	p.up(len(p.data) - 1)
+	return value, priority
+}
+
+// minIndex returns index of item with minimum priority or -1 if heap is empty.
+func (p minMaxPriorityQueue[T]) minIndex() int {
+	if len(p.data) == 0 {
+		return -1
+	}
+	return 0
+}
+
+// maxIndex returns index of item with maximum priority or -1 if heap is empty.
+// Item with maximum priority is the root or one of its children.
+func (p minMaxPriorityQueue[T]) maxIndex() int {
+	switch n := len(p.data); {
+	case n <= 2:
+		return n - 1
+	case p.data[2].priority > p.data[1].priority:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// item returns value and priority of item with index i.
+// If i is -1, it returns default value of type T and false as third value.
+func (p minMaxPriorityQueue[T]) item(i int) (T, int, bool) {
+	if i < 0 {
+		var value T
+		return value, 0, false
+	}
+
+	return p.data[i].value, p.data[i].priority, true
+}
+
+// remove removes item with index i, returns its value and priority.
+// If i is -1, it returns default value of type T and false as third value.
+func (p *minMaxPriorityQueue[T]) remove(i int) (T, int, bool) {
+	value, priority, ok := p.item(i)
+	if !ok {
+		return value, priority, ok
+	}
+
+	// Move the last item to index i and restore heap.
+	n := len(p.data) - 1
+	p.data[i] = p.data[n]
+	p.data[n] = nil
+	p.data = p.data[:n]
+	if i < n {
+		p.down(i)
+	}
+
+	return value, priority, true
+}
+
+// up moves item with index i towards the root to restore heap.
+func (p *minMaxPriorityQueue[T]) up(i int) {
+	if i == 0 {
+		return
+	}
+
+	// If item violates order with its parent, swap them
+	// and move item through levels of parent's kind.
+	isMax := isMaxLevel(i)
+	if parent := (i - 1) / 2; higher(!isMax, p.data[i].priority, p.data[parent].priority) {
+		p.data[i], p.data[parent] = p.data[parent], p.data[i]
+		i, isMax = parent, !isMax
+	}
+
+	// Move item through levels of its kind while it is higher than grandparent.
+	for i > 2 {
+		grandparent := ((i-1)/2 - 1) / 2
+		if !higher(isMax, p.data[i].priority, p.data[grandparent].priority) {
+			break
+		}
+
+		p.data[i], p.data[grandparent] = p.data[grandparent], p.data[i]
+		i = grandparent
+	}
+}
+
+// down moves item with index i towards the leaves to restore heap.
+func (p *minMaxPriorityQueue[T]) down(i int) {
+	isMax := isMaxLevel(i)
+	n := len(p.data)
+	for {
+		// Search for the highest item among children and grandchildren.
+		m := -1
+		for _, j := range [...]int{2*i + 1, 2*i + 2, 4*i + 3, 4*i + 4, 4*i + 5, 4*i + 6} {
+			if j < n && (m == -1 || higher(isMax, p.data[j].priority, p.data[m].priority)) {
+				m = j
+			}
+		}
+
+		if m == -1 || !higher(isMax, p.data[m].priority, p.data[i].priority) {
+			return
+		}
+
+		p.data[i], p.data[m] = p.data[m], p.data[i]
+
+		// If child is swapped, heap is restored.
+		if m <= 2*i+2 {
+			return
+		}
+
+		// If grandchild is swapped, check order with its parent.
+		if parent := (m - 1) / 2; higher(!isMax, p.data[m].priority, p.data[parent].priority) {
+			p.data[m], p.data[parent] = p.data[parent], p.data[m]
+		}
+		i = m
+	}
+}
+
+// isMaxLevel returns true if item with index i is on odd (max) level of heap.
+func isMaxLevel(i int) bool { return bits.Len(uint(i+1))%2 == 0 }
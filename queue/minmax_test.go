@@ -0,0 +1,245 @@
+package queue
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func emptyMinMaxPriorityQueue() DoubleEndedPriorityQueue[int] { return NewMinMaxPriorityQueue[int]() }
+
+func simpleMinMaxPriorityQueue() DoubleEndedPriorityQueue[int] {
+	pq := NewMinMaxPriorityQueue[int]()
+	pq.Push(1, 2)
+	pq.Push(2, 3)
+	pq.Push(3, 1)
+	pq.Push(4, 5)
+	pq.Push(5, 4)
+	return pq
+}
+
+func Test_isMaxLevel(t *testing.T) {
+	tests := []struct {
+		name string
+		i    int
+		want bool
+	}{
+		{"Root", 0, false},
+		{"Child", 2, true},
+		{"Grandchild", 3, false},
+		{"LastGrandchild", 6, false},
+		{"GreatGrandchild", 7, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isMaxLevel(tt.i); got != tt.want {
+				t.Errorf("isMaxLevel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinMaxPriorityQueue_Len(t *testing.T) {
+	tests := []struct {
+		name string
+		p    DoubleEndedPriorityQueue[int]
+		want int
+	}{
+		{"EmptyQueue", emptyMinMaxPriorityQueue(), 0},
+		{"SimpleQueue", simpleMinMaxPriorityQueue(), 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Len(); got != tt.want {
+				t.Errorf("DoubleEndedPriorityQueue.Len() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinMaxPriorityQueue_PeekMin(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     DoubleEndedPriorityQueue[int]
+		want  int
+		want1 int
+		want2 bool
+	}{
+		{"EmptyQueue", emptyMinMaxPriorityQueue(), 0, 0, false},
+		{"SimpleQueue", simpleMinMaxPriorityQueue(), 3, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2 := tt.p.PeekMin()
+			if got != tt.want {
+				t.Errorf("DoubleEndedPriorityQueue.PeekMin() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("DoubleEndedPriorityQueue.PeekMin() got1 = %v, want %v", got1, tt.want1)
+			}
+			if got2 != tt.want2 {
+				t.Errorf("DoubleEndedPriorityQueue.PeekMin() got2 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+}
+
+func TestMinMaxPriorityQueue_PeekMax(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     DoubleEndedPriorityQueue[int]
+		want  int
+		want1 int
+		want2 bool
+	}{
+		{"EmptyQueue", emptyMinMaxPriorityQueue(), 0, 0, false},
+		{"SimpleQueue", simpleMinMaxPriorityQueue(), 4, 5, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2 := tt.p.PeekMax()
+			if got != tt.want {
+				t.Errorf("DoubleEndedPriorityQueue.PeekMax() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("DoubleEndedPriorityQueue.PeekMax() got1 = %v, want %v", got1, tt.want1)
+			}
+			if got2 != tt.want2 {
+				t.Errorf("DoubleEndedPriorityQueue.PeekMax() got2 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+}
+
+func TestMinMaxPriorityQueue_PopMin(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     DoubleEndedPriorityQueue[int]
+		want  int
+		want1 int
+		want2 bool
+	}{
+		{"EmptyQueue", emptyMinMaxPriorityQueue(), 0, 0, false},
+		{"SimpleQueue", simpleMinMaxPriorityQueue(), 3, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2 := tt.p.PopMin()
+			if got != tt.want {
+				t.Errorf("DoubleEndedPriorityQueue.PopMin() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("DoubleEndedPriorityQueue.PopMin() got1 = %v, want %v", got1, tt.want1)
+			}
+			if got2 != tt.want2 {
+				t.Errorf("DoubleEndedPriorityQueue.PopMin() got2 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+}
+
+func TestMinMaxPriorityQueue_PopMax(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     DoubleEndedPriorityQueue[int]
+		want  int
+		want1 int
+		want2 bool
+	}{
+		{"EmptyQueue", emptyMinMaxPriorityQueue(), 0, 0, false},
+		{"SimpleQueue", simpleMinMaxPriorityQueue(), 4, 5, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2 := tt.p.PopMax()
+			if got != tt.want {
+				t.Errorf("DoubleEndedPriorityQueue.PopMax() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("DoubleEndedPriorityQueue.PopMax() got1 = %v, want %v", got1, tt.want1)
+			}
+			if got2 != tt.want2 {
+				t.Errorf("DoubleEndedPriorityQueue.PopMax() got2 = %v, want %v", got2, tt.want2)
+			}
+		})
+	}
+}
+
+func TestMinMaxPriorityQueue_Push(t *testing.T) {
+	type args struct {
+		value    int
+		priority int
+	}
+	tests := []struct {
+		name  string
+		p     DoubleEndedPriorityQueue[int]
+		args  args
+		want  int
+		want1 int
+	}{
+		{"EmptyQueue", emptyMinMaxPriorityQueue(), args{6, 0}, 6, 0},
+		{"SimpleQueue", simpleMinMaxPriorityQueue(), args{6, 0}, 6, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.p.Push(tt.args.value, tt.args.priority)
+			if got != tt.want {
+				t.Errorf("DoubleEndedPriorityQueue.Push() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("DoubleEndedPriorityQueue.Push() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func FuzzMinMaxPriorityQueue(f *testing.F) {
+	for range 100 {
+		b := make([]byte, 200)
+		for i := range b {
+			b[i] = byte(rand.Intn(256))
+		}
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		pq := NewMinMaxPriorityQueue[int]()
+		var want []int // sorted priorities contained in queue
+
+		// Byte with the lowest bits 00 pops minimum, 01 pops maximum, others push.
+		for _, op := range b {
+			var got int
+			var ok bool
+			switch op % 4 {
+			case 0:
+				_, got, ok = pq.PopMin()
+				if len(want) > 0 {
+					if got != want[0] {
+						t.Fatalf("DoubleEndedPriorityQueue.PopMin() got1 = %v, want %v", got, want[0])
+					}
+					want = want[1:]
+				}
+			case 1:
+				_, got, ok = pq.PopMax()
+				if len(want) > 0 {
+					if got != want[len(want)-1] {
+						t.Fatalf("DoubleEndedPriorityQueue.PopMax() got1 = %v, want %v", got, want[len(want)-1])
+					}
+					want = want[:len(want)-1]
+				}
+			default:
+				priority := int(op) / 4
+				pq.Push(0, priority)
+				i, _ := slices.BinarySearch(want, priority)
+				want = slices.Insert(want, i, priority)
+				ok = true
+			}
+
+			if !ok && pq.Len() != 0 {
+				t.Fatal("DoubleEndedPriorityQueue returned false for non-empty queue")
+			}
+			if pq.Len() != len(want) {
+				t.Fatalf("DoubleEndedPriorityQueue.Len() = %v, want %v", pq.Len(), len(want))
+			}
+		}
+	})
+}
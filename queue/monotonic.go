@@ -0,0 +1,78 @@
+package queue
+
+// MonotonicDeque represents abstract double-ended queue
+// whose elements are kept in non-decreasing order according to comparison function.
+// Front element is the minimum of elements pushed since it was inserted.
+type MonotonicDeque[T any] interface {
+	// Len returns number of elements contained in deque.
+	Len() int
+	// Front returns the minimum element of deque.
+	// If deque is empty, it returns default value of type T and false as second value.
+	Front() (T, bool)
+	// Back returns the most recently pushed element.
+	// If deque is empty, it returns default value of type T and false as second value.
+	Back() (T, bool)
+	// PopFront removes the minimum element from deque and returns it.
+	// If deque is empty, it returns default value of type T and false as second value.
+	PopFront() (T, bool)
+	// PushBack removes elements greater than value from back of deque,
+	// then inserts value at back of deque.
+	// It returns the inserted value.
+	PushBack(value T) T
+	// Evict removes front element if it is equal to value.
+	// It returns true if element is removed.
+	Evict(value T) bool
+}
+
+// monotonicDeque implements monotonic deque based on double-ended queue.
+type monotonicDeque[T any] struct {
+	data Deque[T]
+	cmp  func(a, b T) int
+}
+
+// NewMonotonicDeque returns new monotonic deque based on ring buffer.
+// cmp should return a negative number if a precedes b, a positive number if a follows b,
+// and 0 if a and b are equal. To keep the maximum at front, pass reversed comparison function.
+func NewMonotonicDeque[T any](cmp func(a, b T) int) MonotonicDeque[T] {
+	return &monotonicDeque[T]{NewRingDeque[T](), cmp}
+}
+
+// Len returns number of elements contained in deque, O(1).
+func (d monotonicDeque[T]) Len() int { return d.data.Len() }
+
+// Front returns the minimum element of deque, O(1).
+// If deque is empty, it returns default value of type T and false as second value.
+func (d monotonicDeque[T]) Front() (T, bool) { return d.data.Front() }
+
+// Back returns the most recently pushed element, O(1).
+// If deque is empty, it returns default value of type T and false as second value.
+func (d monotonicDeque[T]) Back() (T, bool) { return d.data.Back() }
+
+// PopFront removes the minimum element from deque and returns it, O(1).
+// If deque is empty, it returns default value of type T and false as second value.
+func (d *monotonicDeque[T]) PopFront() (T, bool) { return d.data.PopFront() }
+
+// PushBack removes elements greater than value from back of deque,
+// then inserts value at back of deque, amortized O(1).
+// Equal elements are kept, so each of them can be evicted separately.
+// It returns the inserted value.
+func (d *monotonicDeque[T]) PushBack(value T) T {
+	for back, ok := d.data.Back(); ok && d.cmp(back, value) > 0; back, ok = d.data.Back() {
+		d.data.PopBack()
+	}
+
+	return d.data.PushBack(value)
+}
+
+// Evict removes front element if it is equal to value, O(1).
+// It is used to remove element leaving sliding window:
+// if element is not at front, it has already been removed by PushBack.
+// It returns true if element is removed.
+func (d *monotonicDeque[T]) Evict(value T) bool {
+	if front, ok := d.data.Front(); ok && d.cmp(front, value) == 0 {
+		d.data.PopFront()
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,72 @@
+package queue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func intCmp(a, b int) int { return a - b }
+
+// monotonicDequeSlice pops all elements of deque and returns them.
+func monotonicDequeSlice(d MonotonicDeque[int]) []int {
+	var s []int
+	for d.Len() > 0 {
+		value, _ := d.PopFront()
+		s = append(s, value)
+	}
+
+	return s
+}
+
+func TestMonotonicDeque_PushBack(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []int
+	}{
+		{"Increasing", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"Decreasing", []int{3, 2, 1}, []int{1}},
+		{"Equal", []int{2, 2, 2}, []int{2, 2, 2}},
+		{"Mixed", []int{5, 1, 4, 2, 3}, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewMonotonicDeque(intCmp)
+			for _, value := range tt.values {
+				if got := d.PushBack(value); got != value {
+					t.Errorf("MonotonicDeque.PushBack() = %v, want %v", got, value)
+				}
+			}
+			if got := monotonicDequeSlice(d); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MonotonicDeque = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonotonicDeque_Evict(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int
+		want  bool
+		want1 []int
+	}{
+		{"Front", 1, true, []int{1, 3}},
+		{"NotFront", 3, false, []int{1, 1, 3}},
+		{"Removed", 5, false, []int{1, 1, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewMonotonicDeque(intCmp)
+			for _, value := range []int{5, 1, 1, 3} {
+				d.PushBack(value)
+			}
+			if got := d.Evict(tt.value); got != tt.want {
+				t.Errorf("MonotonicDeque.Evict() = %v, want %v", got, tt.want)
+			}
+			if got := monotonicDequeSlice(d); !reflect.DeepEqual(got, tt.want1) {
+				t.Errorf("MonotonicDeque = %v after Evict(), want %v", got, tt.want1)
+			}
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package queue
+
+// pairingNode implements a pairing heap node.
+// Children of node are linked through sibling pointers.
+type pairingNode[T any] struct {
+	value    T
+	priority int
+	child    *pairingNode[T] // first child
+	sibling  *pairingNode[T] // next sibling
+	prev     *pairingNode[T] // parent if node is the first child, otherwise previous sibling
+}
+
+// pairingPriorityQueue implements priority queue based on pairing min/max heap.
+// Element with the highest priority is the root of heap.
+type pairingPriorityQueue[T any] struct {
+	root *pairingNode[T]
+	len  int
+	max  bool // if true, maximum priority value is the highest priority
+}
+
+// NewMinPairingPriorityQueue returns new priority queue based on pairing min heap.
+func NewMinPairingPriorityQueue[T any]() MergeablePriorityQueue[T] {
+	return new(pairingPriorityQueue[T])
+}
+
+// NewMaxPairingPriorityQueue returns new priority queue based on pairing max heap.
+func NewMaxPairingPriorityQueue[T any]() MergeablePriorityQueue[T] {
+	return &pairingPriorityQueue[T]{max: true}
+}
+
+// Len returns number of elements contained in queue, O(1).
+func (p pairingPriorityQueue[T]) Len() int { return p.len }
+
+// Front returns element of queue that has the highest priority in heap, O(1).
+// It also returns element's priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p pairingPriorityQueue[T]) Front() (T, int, bool) {
+	if p.root != nil {
+		return p.root.value, p.root.priority, true
+	}
+
+	var value T
+	return value, 0, false
+}
+
+// PopFront removes element of queue that has the highest priority in heap, amortized O(log(n)).
+// It returns this element and its priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p *pairingPriorityQueue[T]) PopFront() (T, int, bool) {
+	root := p.root
+	if root == nil {
+		var value T
+		return value, 0, false
+	}
+
+	p.root = p.mergePairs(root.child)
+	p.len--
+
+	// Avoid memory leaks.
+	root.child = nil
+	return root.value, root.priority, true
+}
+
+// Push inserts new value with priority into queue, O(1).
+// It returns the inserted value and its priority.
+func (p *pairingPriorityQueue[T]) Push(value T, priority int) (T, int) {
+	p.push(value, priority)
+	return value, priority
+}
+
+// push inserts new value with priority into queue and returns its node.
+func (p *pairingPriorityQueue[T]) push(value T, priority int) *pairingNode[T] {
+	node := &pairingNode[T]{value: value, priority: priority}
+	p.root = p.meld(p.root, node)
+	p.len++
+	return node
+}
+
+// increase changes priority of node to not lower priority, O(1).
+// Node is cut from its parent and melded with root.
+func (p *pairingPriorityQueue[T]) increase(node *pairingNode[T], priority int) {
+	node.priority = priority
+	if node != p.root {
+		cutPairing(node)
+		p.root = p.meld(p.root, node)
+	}
+}
+
+// remove removes node from queue, amortized O(log(n)).
+func (p *pairingPriorityQueue[T]) remove(node *pairingNode[T]) {
+	if node == p.root {
+		p.PopFront()
+		return
+	}
+
+	cutPairing(node)
+	p.root = p.meld(p.root, p.mergePairs(node.child))
+	p.len--
+
+	// Avoid memory leaks.
+	node.child = nil
+}
+
+// Merge moves all elements of other into queue.
+// If other is pairing heap with the same order, complexity is O(1),
+// otherwise elements are popped from other and pushed into queue one by one.
+// other becomes empty after merging.
+func (p *pairingPriorityQueue[T]) Merge(other PriorityQueue[T]) {
+	if o, ok := other.(*pairingPriorityQueue[T]); ok && o.max == p.max {
+		if o != p {
+			p.root = p.meld(p.root, o.root)
+			p.len += o.len
+			o.root = nil
+			o.len = 0
+		}
+		return
+	}
+
+	mergeByPopping(p, other)
+}
+
+// meld links two heaps and returns root of the resulting heap.
+// Root with lower priority becomes the first child of the other root.
+func (p *pairingPriorityQueue[T]) meld(a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if higher(p.max, b.priority, a.priority) {
+		a, b = b, a
+	}
+
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	b.prev = a
+	a.child = b
+	return a
+}
+
+// mergePairs melds list of sibling heaps into one heap using two-pass pairing
+// and returns its root.
+func (p *pairingPriorityQueue[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	// First pass: meld siblings in pairs from left to right,
+	// resulting heaps are linked in reverse order.
+	var pairs *pairingNode[T]
+	for first != nil {
+		a, b := first, first.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+			b.sibling = nil
+		}
+		a.sibling = nil
+		a.prev = nil
+
+		pair := p.meld(a, b)
+		pair.sibling = pairs
+		pairs = pair
+	}
+
+	// Second pass: meld resulting heaps from right to left.
+	var root *pairingNode[T]
+	for pairs != nil {
+		next := pairs.sibling
+		pairs.sibling = nil
+		root = p.meld(root, pairs)
+		pairs = next
+	}
+
+	return root
+}
+
+// cutPairing removes node with its subtree from list of siblings.
+func cutPairing[T any](node *pairingNode[T]) {
+	if node.prev.child == node {
+		node.prev.child = node.sibling
+	} else {
+		node.prev.sibling = node.sibling
+	}
+	if node.sibling != nil {
+		node.sibling.prev = node.prev
+	}
+
+	node.prev = nil
+	node.sibling = nil
+}
+
+// indexedPairingPriorityQueue implements indexed priority queue based on pairing min/max heap
+// and hash table of heap nodes.
+type indexedPairingPriorityQueue[T comparable] struct {
+	pairingPriorityQueue[T]
+	nodes map[T]*pairingNode[T]
+}
+
+// NewIndexedMinPairingPriorityQueue returns new indexed priority queue based on pairing min heap.
+// Update that decreases priority value is O(1), so it suits decrease-key-heavy workloads.
+func NewIndexedMinPairingPriorityQueue[T comparable]() IndexedPriorityQueue[T] {
+	return &indexedPairingPriorityQueue[T]{nodes: make(map[T]*pairingNode[T])}
+}
+
+// NewIndexedMaxPairingPriorityQueue returns new indexed priority queue based on pairing max heap.
+// Update that increases priority value is O(1), so it suits increase-key-heavy workloads.
+func NewIndexedMaxPairingPriorityQueue[T comparable]() IndexedPriorityQueue[T] {
+	return &indexedPairingPriorityQueue[T]{pairingPriorityQueue[T]{max: true}, make(map[T]*pairingNode[T])}
+}
+
+// PopFront removes element of queue that has the highest priority in heap, amortized O(log(n)).
+// It returns this element and its priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p *indexedPairingPriorityQueue[T]) PopFront() (T, int, bool) {
+	value, priority, ok := p.pairingPriorityQueue.PopFront()
+	if ok {
+		delete(p.nodes, value)
+	}
+
+	return value, priority, ok
+}
+
+// Push inserts new value with priority into queue, O(1).
+// If value is already contained in queue, its priority is updated.
+// It returns the inserted value and its priority.
+func (p *indexedPairingPriorityQueue[T]) Push(value T, priority int) (T, int) {
+	if !p.Update(value, priority) {
+		p.nodes[value] = p.push(value, priority)
+	}
+
+	return value, priority
+}
+
+// Merge moves all elements of other into queue, O(m*log(n+m)).
+// If value is contained in both queues, its priority is taken from other.
+// other becomes empty after merging.
+func (p *indexedPairingPriorityQueue[T]) Merge(other PriorityQueue[T]) { mergeByPopping(p, other) }
+
+// Range calls fn for each element and its priority
+// from the highest to the lowest priority until fn returns false, O(n*log(n)).
+// Queue is not modified.
+func (p indexedPairingPriorityQueue[T]) Range(fn func(value T, priority int) bool) {
+	rangeIndexed(p.nodes, p.max, func(node *pairingNode[T]) int { return node.priority }, fn)
+}
+
+// ToSlice returns new slice with elements of queue
+// from the highest to the lowest priority, O(n*log(n)).
+func (p indexedPairingPriorityQueue[T]) ToSlice() []T {
+	s := make([]T, 0, p.len)
+	p.Range(func(value T, _ int) bool {
+		s = append(s, value)
+		return true
+	})
+	return s
+}
+
+// Clear removes all elements from queue, O(n).
+func (p *indexedPairingPriorityQueue[T]) Clear() {
+	p.root = nil
+	p.len = 0
+	clear(p.nodes)
+}
+
+// Drain removes the highest-priority elements and calls fn for each of them and its priority
+// until fn returns false or queue becomes empty, amortized O(k*log(n)).
+// Element for which fn returns false is removed too.
+func (p *indexedPairingPriorityQueue[T]) Drain(fn func(value T, priority int) bool) {
+	drainIndexed(p, fn)
+}
+
+// Contains returns true if value is contained in queue, O(1).
+func (p indexedPairingPriorityQueue[T]) Contains(value T) bool {
+	_, ok := p.nodes[value]
+	return ok
+}
+
+// Priority returns priority of value, O(1).
+// If value is not contained in queue, it returns 0 and false as second value.
+func (p indexedPairingPriorityQueue[T]) Priority(value T) (int, bool) {
+	if node, ok := p.nodes[value]; ok {
+		return node.priority, true
+	}
+
+	return 0, false
+}
+
+// Update changes priority of value contained in queue.
+// If new priority is not lower, complexity is O(1), otherwise amortized O(log(n)).
+// If value is not contained in queue, it returns false.
+func (p *indexedPairingPriorityQueue[T]) Update(value T, priority int) bool {
+	node, ok := p.nodes[value]
+	if !ok {
+		return false
+	}
+
+	if !higher(p.max, node.priority, priority) {
+		p.increase(node, priority)
+	} else {
+		p.remove(node)
+		p.nodes[value] = p.push(value, priority)
+	}
+	return true
+}
+
+// Remove removes value from queue and returns its priority, amortized O(log(n)).
+// If value is not contained in queue, it returns 0 and false as second value.
+func (p *indexedPairingPriorityQueue[T]) Remove(value T) (int, bool) {
+	node, ok := p.nodes[value]
+	if !ok {
+		return 0, false
+	}
+
+	p.remove(node)
+	delete(p.nodes, value)
+	return node.priority, true
+}
@@ -0,0 +1,26 @@
+package queue
+
+import "testing"
+
+func TestPairingPriorityQueue(t *testing.T) {
+	testPriorityQueue(t,
+		func() PriorityQueue[int] { return NewMinPairingPriorityQueue[int]() },
+		func() PriorityQueue[int] { return NewMaxPairingPriorityQueue[int]() },
+	)
+}
+
+func TestPairingPriorityQueue_Merge(t *testing.T) {
+	testMergeablePriorityQueue(t, NewMinPairingPriorityQueue[int], NewMaxPairingPriorityQueue[int])
+}
+
+func BenchmarkPairingPriorityQueue(b *testing.B) {
+	benchmarkPriorityQueue(b, func() PriorityQueue[int] { return NewMinPairingPriorityQueue[int]() })
+}
+
+func TestIndexedPairingPriorityQueue(t *testing.T) {
+	testPriorityQueue(t,
+		func() PriorityQueue[int] { return NewIndexedMinPairingPriorityQueue[int]() },
+		func() PriorityQueue[int] { return NewIndexedMaxPairingPriorityQueue[int]() },
+	)
+	testIndexedPriorityQueue(t, NewIndexedMinPairingPriorityQueue[int], NewIndexedMaxPairingPriorityQueue[int])
+}
@@ -0,0 +1,270 @@
+package queue
+
+import "slices"
+
+// PersistentQueue represents abstract immutable queue.
+// Modifying operations return new versions of queue, old versions remain valid.
+type PersistentQueue[T any] interface {
+	// Len returns number of elements contained in queue.
+	Len() int
+	// Front returns first element of queue.
+	// If queue is empty, it returns default value of type T and false as second value.
+	Front() (T, bool)
+	// Back returns last element of queue.
+	// If queue is empty, it returns default value of type T and false as second value.
+	Back() (T, bool)
+	// PopFront returns first element of queue and new version of queue without it.
+	// If queue is empty, it returns default value of type T, the same queue and false as third value.
+	PopFront() (T, PersistentQueue[T], bool)
+	// PushBack returns new version of queue with value inserted at back.
+	PushBack(value T) PersistentQueue[T]
+}
+
+// PersistentDeque represents abstract immutable double-ended queue.
+// Modifying operations return new versions of deque, old versions remain valid.
+type PersistentDeque[T any] interface {
+	// Len returns number of elements contained in deque.
+	Len() int
+	// Front returns first element of deque.
+	// If deque is empty, it returns default value of type T and false as second value.
+	Front() (T, bool)
+	// Back returns last element of deque.
+	// If deque is empty, it returns default value of type T and false as second value.
+	Back() (T, bool)
+	// PopFront returns first element of deque and new version of deque without it.
+	// If deque is empty, it returns default value of type T, the same deque and false as third value.
+	PopFront() (T, PersistentDeque[T], bool)
+	// PopBack returns last element of deque and new version of deque without it.
+	// If deque is empty, it returns default value of type T, the same deque and false as third value.
+	PopBack() (T, PersistentDeque[T], bool)
+	// PushFront returns new version of deque with value inserted at front.
+	PushFront(value T) PersistentDeque[T]
+	// PushBack returns new version of deque with value inserted at back.
+	PushBack(value T) PersistentDeque[T]
+}
+
+// fingerNode implements an element of finger tree.
+// Leaves contain values, inner nodes contain 2 or 3 nodes of the previous level.
+// Nodes are shared between versions and never modified after creation.
+type fingerNode[T any] struct {
+	value    T
+	children []*fingerNode[T]
+}
+
+// fingerTree implements immutable 2-3 finger tree without measures.
+// Nil tree is empty, tree with single node contains only it.
+// Otherwise tree is deep: it contains prefix and suffix digits of 1-4 nodes
+// and middle tree of inner nodes of the next level.
+// Digits are never modified after creation, new versions get new digits
+// and share the rest of tree.
+type fingerTree[T any] struct {
+	single *fingerNode[T]
+	prefix []*fingerNode[T]
+	middle *fingerTree[T]
+	suffix []*fingerNode[T]
+}
+
+// digitTree returns tree with 1-4 nodes of digit.
+func digitTree[T any](digit []*fingerNode[T]) *fingerTree[T] {
+	if len(digit) == 1 {
+		return &fingerTree[T]{single: digit[0]}
+	}
+
+	n := len(digit) / 2
+	return &fingerTree[T]{prefix: digit[:n], suffix: digit[n:]}
+}
+
+// front returns the first node of non-empty tree, O(1).
+func (t *fingerTree[T]) front() *fingerNode[T] {
+	if t.single != nil {
+		return t.single
+	}
+	return t.prefix[0]
+}
+
+// back returns the last node of non-empty tree, O(1).
+func (t *fingerTree[T]) back() *fingerNode[T] {
+	if t.single != nil {
+		return t.single
+	}
+	return t.suffix[len(t.suffix)-1]
+}
+
+// pushFront returns new tree with node inserted at front, O(log(n)).
+func (t *fingerTree[T]) pushFront(node *fingerNode[T]) *fingerTree[T] {
+	switch {
+	case t == nil:
+		return &fingerTree[T]{single: node}
+	case t.single != nil:
+		return &fingerTree[T]{prefix: []*fingerNode[T]{node}, suffix: []*fingerNode[T]{t.single}}
+	case len(t.prefix) < 4:
+		return &fingerTree[T]{prefix: slices.Concat([]*fingerNode[T]{node}, t.prefix), middle: t.middle, suffix: t.suffix}
+	}
+
+	// Full prefix keeps two nodes, other three are moved to middle tree.
+	inner := &fingerNode[T]{children: t.prefix[1:]}
+	return &fingerTree[T]{prefix: []*fingerNode[T]{node, t.prefix[0]}, middle: t.middle.pushFront(inner), suffix: t.suffix}
+}
+
+// pushBack returns new tree with node inserted at back, O(log(n)).
+func (t *fingerTree[T]) pushBack(node *fingerNode[T]) *fingerTree[T] {
+	switch {
+	case t == nil:
+		return &fingerTree[T]{single: node}
+	case t.single != nil:
+		return &fingerTree[T]{prefix: []*fingerNode[T]{t.single}, suffix: []*fingerNode[T]{node}}
+	case len(t.suffix) < 4:
+		return &fingerTree[T]{prefix: t.prefix, middle: t.middle, suffix: slices.Concat(t.suffix, []*fingerNode[T]{node})}
+	}
+
+	// Full suffix keeps two nodes, other three are moved to middle tree.
+	inner := &fingerNode[T]{children: t.suffix[:3]}
+	return &fingerTree[T]{prefix: t.prefix, middle: t.middle.pushBack(inner), suffix: []*fingerNode[T]{t.suffix[3], node}}
+}
+
+// popFront returns the first node of non-empty tree and new tree without it, O(log(n)).
+func (t *fingerTree[T]) popFront() (*fingerNode[T], *fingerTree[T]) {
+	switch {
+	case t.single != nil:
+		return t.single, nil
+	case len(t.prefix) > 1:
+		return t.prefix[0], &fingerTree[T]{prefix: t.prefix[1:], middle: t.middle, suffix: t.suffix}
+	case t.middle == nil:
+		return t.prefix[0], digitTree(t.suffix)
+	}
+
+	// Empty prefix is replaced with children of the first node of middle tree.
+	inner, middle := t.middle.popFront()
+	return t.prefix[0], &fingerTree[T]{prefix: inner.children, middle: middle, suffix: t.suffix}
+}
+
+// popBack returns the last node of non-empty tree and new tree without it, O(log(n)).
+func (t *fingerTree[T]) popBack() (*fingerNode[T], *fingerTree[T]) {
+	last := len(t.suffix) - 1
+	switch {
+	case t.single != nil:
+		return t.single, nil
+	case last > 0:
+		return t.suffix[last], &fingerTree[T]{prefix: t.prefix, middle: t.middle, suffix: t.suffix[:last]}
+	case t.middle == nil:
+		return t.suffix[last], digitTree(t.prefix)
+	}
+
+	// Empty suffix is replaced with children of the last node of middle tree.
+	inner, middle := t.middle.popBack()
+	return t.suffix[last], &fingerTree[T]{prefix: t.prefix, middle: middle, suffix: inner.children}
+}
+
+// fingerDeque implements persistent double-ended queue based on finger tree.
+// All versions share nodes, each operation creates O(log(n)) new nodes
+// and digits in the worst case.
+// Worst-case bounds hold for any usage of versions,
+// including modification of the same version many times.
+type fingerDeque[T any] struct {
+	tree *fingerTree[T]
+	len  int
+}
+
+// NewPersistentDeque returns new empty persistent deque.
+func NewPersistentDeque[T any]() PersistentDeque[T] { return fingerDeque[T]{} }
+
+// Len returns number of elements contained in deque, O(1).
+func (d fingerDeque[T]) Len() int { return d.len }
+
+// Front returns first element of deque, O(1).
+// If deque is empty, it returns default value of type T and false as second value.
+func (d fingerDeque[T]) Front() (T, bool) {
+	if d.tree == nil {
+		var value T
+		return value, false
+	}
+	return d.tree.front().value, true
+}
+
+// Back returns last element of deque, O(1).
+// If deque is empty, it returns default value of type T and false as second value.
+func (d fingerDeque[T]) Back() (T, bool) {
+	if d.tree == nil {
+		var value T
+		return value, false
+	}
+	return d.tree.back().value, true
+}
+
+// PopFront returns first element of deque and new version of deque without it,
+// O(log(n)) in the worst case.
+// If deque is empty, it returns default value of type T, the same deque and false as third value.
+func (d fingerDeque[T]) PopFront() (T, PersistentDeque[T], bool) {
+	value, rest, ok := d.popFront()
+	return value, rest, ok
+}
+
+// PopBack returns last element of deque and new version of deque without it,
+// O(log(n)) in the worst case.
+// If deque is empty, it returns default value of type T, the same deque and false as third value.
+func (d fingerDeque[T]) PopBack() (T, PersistentDeque[T], bool) {
+	if d.tree == nil {
+		var value T
+		return value, d, false
+	}
+
+	node, tree := d.tree.popBack()
+	return node.value, fingerDeque[T]{tree, d.len - 1}, true
+}
+
+// PushFront returns new version of deque with value inserted at front,
+// O(log(n)) in the worst case.
+func (d fingerDeque[T]) PushFront(value T) PersistentDeque[T] {
+	return fingerDeque[T]{d.tree.pushFront(&fingerNode[T]{value: value}), d.len + 1}
+}
+
+// PushBack returns new version of deque with value inserted at back,
+// O(log(n)) in the worst case.
+func (d fingerDeque[T]) PushBack(value T) PersistentDeque[T] { return d.pushBack(value) }
+
+// popFront removes first element and returns it with new version of deque.
+func (d fingerDeque[T]) popFront() (T, fingerDeque[T], bool) {
+	if d.tree == nil {
+		var value T
+		return value, d, false
+	}
+
+	node, tree := d.tree.popFront()
+	return node.value, fingerDeque[T]{tree, d.len - 1}, true
+}
+
+// pushBack returns new version of deque with value inserted at back.
+func (d fingerDeque[T]) pushBack(value T) fingerDeque[T] {
+	return fingerDeque[T]{d.tree.pushBack(&fingerNode[T]{value: value}), d.len + 1}
+}
+
+// fingerQueue implements persistent queue based on finger tree deque.
+type fingerQueue[T any] struct{ data fingerDeque[T] }
+
+// NewPersistentQueue returns new empty persistent queue.
+func NewPersistentQueue[T any]() PersistentQueue[T] { return fingerQueue[T]{} }
+
+// Len returns number of elements contained in queue, O(1).
+func (q fingerQueue[T]) Len() int { return q.data.Len() }
+
+// Front returns first element of queue, O(1).
+// If queue is empty, it returns default value of type T and false as second value.
+func (q fingerQueue[T]) Front() (T, bool) { return q.data.Front() }
+
+// Back returns last element of queue, O(1).
+// If queue is empty, it returns default value of type T and false as second value.
+func (q fingerQueue[T]) Back() (T, bool) { return q.data.Back() }
+
+// PopFront returns first element of queue and new version of queue without it,
+// O(log(n)) in the worst case.
+// If queue is empty, it returns default value of type T, the same queue and false as third value.
+func (q fingerQueue[T]) PopFront() (T, PersistentQueue[T], bool) {
+	value, data, ok := q.data.popFront()
+	return value, fingerQueue[T]{data}, ok
+}
+
+// PushBack returns new version of queue with value inserted at back,
+// O(log(n)) in the worst case.
+func (q fingerQueue[T]) PushBack(value T) PersistentQueue[T] {
+	return fingerQueue[T]{q.data.pushBack(value)}
+}
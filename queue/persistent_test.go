@@ -0,0 +1,189 @@
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// persistentDequeSlice returns elements of deque from front to back.
+func persistentDequeSlice(d PersistentDeque[int]) []int {
+	s := make([]int, 0, d.Len())
+	for d.Len() > 0 {
+		var value int
+		value, d, _ = d.PopFront()
+		s = append(s, value)
+	}
+
+	return s
+}
+
+func TestPersistentQueue(t *testing.T) {
+	q0 := NewPersistentQueue[int]()
+	q1 := q0.PushBack(1)
+	q2 := q1.PushBack(2)
+	q3 := q2.PushBack(3)
+	value, q4, ok := q3.PopFront()
+	q5 := q4.PushBack(4)
+
+	tests := []struct {
+		name  string
+		q     PersistentQueue[int]
+		want  int
+		want1 int
+		want2 int
+		want3 bool
+	}{
+		{"EmptyQueue", q0, 0, 0, 0, false},
+		{"SingleElement", q1, 1, 1, 1, true},
+		{"TwoElements", q2, 2, 1, 2, true},
+		{"ThreeElements", q3, 3, 1, 3, true},
+		{"Popped", q4, 2, 2, 3, true},
+		{"PushedAfterPop", q5, 3, 2, 4, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.q.Len(); got != tt.want {
+				t.Errorf("PersistentQueue.Len() = %v, want %v", got, tt.want)
+			}
+			if got, ok := tt.q.Front(); got != tt.want1 || ok != tt.want3 {
+				t.Errorf("PersistentQueue.Front() = (%v, %v), want (%v, %v)", got, ok, tt.want1, tt.want3)
+			}
+			if got, ok := tt.q.Back(); got != tt.want2 || ok != tt.want3 {
+				t.Errorf("PersistentQueue.Back() = (%v, %v), want (%v, %v)", got, ok, tt.want2, tt.want3)
+			}
+		})
+	}
+
+	if value != 1 || !ok {
+		t.Errorf("PersistentQueue.PopFront() = (%v, %v), want (%v, %v)", value, ok, 1, true)
+	}
+	if value, q, ok := q0.PopFront(); value != 0 || q != q0 || ok {
+		t.Errorf("PersistentQueue.PopFront() = (%v, %v, %v), want (%v, %v, %v)", value, q, ok, 0, q0, false)
+	}
+}
+
+func TestPersistentDeque_Pop(t *testing.T) {
+	d := NewPersistentDeque[int]().PushBack(2).PushBack(3).PushFront(1)
+	tests := []struct {
+		name  string
+		op    func(d PersistentDeque[int]) (int, PersistentDeque[int], bool)
+		want  int
+		want1 []int
+	}{
+		{"PopFront", PersistentDeque[int].PopFront, 1, []int{2, 3}},
+		{"PopBack", PersistentDeque[int].PopBack, 3, []int{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, ok := tt.op(d)
+			if got != tt.want || !ok {
+				t.Errorf("PersistentDeque.%v() got = (%v, %v), want (%v, %v)", tt.name, got, ok, tt.want, true)
+			}
+			if s := persistentDequeSlice(got1); !reflect.DeepEqual(s, tt.want1) {
+				t.Errorf("PersistentDeque.%v() got1 = %v, want %v", tt.name, s, tt.want1)
+			}
+			if s := persistentDequeSlice(d); !reflect.DeepEqual(s, []int{1, 2, 3}) {
+				t.Errorf("PersistentDeque = %v after %v(), want %v", s, tt.name, []int{1, 2, 3})
+			}
+		})
+	}
+}
+
+func FuzzPersistentDeque(f *testing.F) {
+	for range 100 {
+		b := make([]byte, 200)
+		for i := range b {
+			b[i] = byte(rand.Intn(256))
+		}
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		// Each operation is applied to a version chosen by the next byte,
+		// so versions are modified many times.
+		versions := []PersistentDeque[int]{NewPersistentDeque[int]()}
+		wants := [][]int{nil} // elements expected to be contained in versions
+
+		for i := 0; i+1 < len(b); i += 2 {
+			op, j := b[i], int(b[i+1])%len(versions)
+			d, want := versions[j], wants[j]
+
+			var got, wantValue int
+			var ok, wantOk bool
+			switch op % 4 {
+			case 0:
+				got, d, ok = d.PopFront()
+				if wantOk = len(want) > 0; wantOk {
+					wantValue, want = want[0], want[1:]
+				}
+			case 1:
+				got, d, ok = d.PopBack()
+				if wantOk = len(want) > 0; wantOk {
+					wantValue, want = want[len(want)-1], want[:len(want)-1]
+				}
+			case 2:
+				d = d.PushFront(i)
+				want = append([]int{i}, want...)
+			default:
+				d = d.PushBack(i)
+				want = append(want[:len(want):len(want)], i)
+			}
+
+			if got != wantValue || ok != wantOk {
+				t.Fatalf("operation %v: got (%v, %v), want (%v, %v)", op%4, got, ok, wantValue, wantOk)
+			}
+			versions, wants = append(versions, d), append(wants, want)
+		}
+
+		for i, d := range versions {
+			if s := persistentDequeSlice(d); !reflect.DeepEqual(s, append([]int{}, wants[i]...)) {
+				t.Fatalf("version %v = %v, want %v", i, s, wants[i])
+			}
+			if front, _ := d.Front(); len(wants[i]) > 0 && front != wants[i][0] {
+				t.Fatalf("version %v: PersistentDeque.Front() = %v, want %v", i, front, wants[i][0])
+			}
+			if back, _ := d.Back(); len(wants[i]) > 0 && back != wants[i][len(wants[i])-1] {
+				t.Fatalf("version %v: PersistentDeque.Back() = %v, want %v", i, back, wants[i][len(wants[i])-1])
+			}
+		}
+	})
+}
+
+func TestPersistentDeque_SharedVersion(t *testing.T) {
+	// Deep tree is built by pushes at both ends.
+	d, want := NewPersistentDeque[int](), []int{}
+	for i := range 10000 {
+		if i%3 == 0 {
+			d, want = d.PushFront(i), append([]int{i}, want...)
+		} else {
+			d, want = d.PushBack(i), append(want, i)
+		}
+	}
+
+	// The same version is popped from both ends many times.
+	for i := range 100 {
+		front, popped, _ := d.PopFront()
+		back, popped, _ := popped.PopBack()
+		if front != want[0] || back != want[len(want)-1] || popped.Len() != len(want)-2 {
+			t.Fatalf("pop %v: got (%v, %v, %v), want (%v, %v, %v)",
+				i, front, back, popped.Len(), want[0], want[len(want)-1], len(want)-2)
+		}
+	}
+
+	if s := persistentDequeSlice(d); !reflect.DeepEqual(s, want) {
+		t.Errorf("PersistentDeque = %v, want %v", s, want)
+	}
+}
+
+func BenchmarkPersistentDeque_SharedVersion(b *testing.B) {
+	d := NewPersistentDeque[int]()
+	for i := range 100000 {
+		d = d.PushBack(i)
+	}
+
+	b.ResetTimer()
+	for range b.N {
+		_, popped, _ := d.PopFront()
+		popped.PopBack()
+	}
+}
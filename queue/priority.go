@@ -17,19 +17,52 @@ type PriorityQueue[T any] interface {
 	Push(value T, priority int) (T, int)
 }
 
+// MergeablePriorityQueue represents abstract priority queue
+// that can be merged with another priority queue.
+type MergeablePriorityQueue[T any] interface {
+	PriorityQueue[T]
+	// Merge moves all elements of other into queue.
+	// other becomes empty after merging.
+	Merge(other PriorityQueue[T])
+}
+
+// IterablePriorityQueue represents abstract priority queue
+// that supports iteration and bulk operations.
+type IterablePriorityQueue[T any] interface {
+	PriorityQueue[T]
+	// Range calls fn for each element and its priority
+	// from the highest to the lowest priority until fn returns false.
+	Range(fn func(value T, priority int) bool)
+	// ToSlice returns new slice with elements of queue
+	// from the highest to the lowest priority.
+	ToSlice() []T
+	// Clear removes all elements from queue.
+	Clear()
+	// Drain removes the highest-priority elements and calls fn for each of them and its priority
+	// until fn returns false or queue becomes empty.
+	Drain(fn func(value T, priority int) bool)
+}
+
+// MergeableIterablePriorityQueue represents abstract priority queue
+// that can be merged with another priority queue and supports iteration and bulk operations.
+type MergeableIterablePriorityQueue[T any] interface {
+	MergeablePriorityQueue[T]
+	IterablePriorityQueue[T]
+}
+
 // priorityQueue implements priority queue based on min/max heap.
 // Element with the highest priority has min/max value in heap.
 type priorityQueue[T any] struct {
 	data prioritySlice[T]
 }
 
-// NewMaxPriorityQueue returns new priority queue based on min heap.
-func NewMinPriorityQueue[T any]() PriorityQueue[T] {
+// NewMinPriorityQueue returns new priority queue based on min heap.
+func NewMinPriorityQueue[T any]() MergeableIterablePriorityQueue[T] {
 	return &priorityQueue[T]{new(minPrioritySlice[T])}
 }
 
 // NewMaxPriorityQueue returns new priority queue based on max heap.
-func NewMaxPriorityQueue[T any]() PriorityQueue[T] {
+func NewMaxPriorityQueue[T any]() MergeableIterablePriorityQueue[T] {
 	return &priorityQueue[T]{new(maxPrioritySlice[T])}
 }
 
@@ -73,18 +106,102 @@ func (p *priorityQueue[T]) Push(value T, priority int) (T, int) {
 	return item.value, priority
 }
 
+// Merge moves all elements of other into queue.
+// If other is binary heap with the same order, items are appended and heap is rebuilt in O(n+m),
+// otherwise elements are popped from other and pushed into queue one by one.
+// other becomes empty after merging.
+func (p *priorityQueue[T]) Merge(other PriorityQueue[T]) {
+	o, ok := other.(*priorityQueue[T])
+	if o == p {
+		return
+	}
+	if !ok || !p.data.merge(o.data) {
+		mergeByPopping(p, other)
+		return
+	}
+
+	heap.Init(p.data)
+}
+
+// Range calls fn for each element and its priority
+// from the highest to the lowest priority until fn returns false, O(n*log(n)).
+// Queue is not modified, elements are popped from its copy.
+func (p priorityQueue[T]) Range(fn func(value T, priority int) bool) {
+	data := p.data.Clone()
+	for data.Len() > 0 {
+		item := heap.Pop(data).(*PriorityItem[T])
+		if !fn(item.value, item.priority) {
+			return
+		}
+	}
+}
+
+// ToSlice returns new slice with elements of queue
+// from the highest to the lowest priority, O(n*log(n)).
+func (p priorityQueue[T]) ToSlice() []T {
+	s := make([]T, 0, p.data.Len())
+	p.Range(func(value T, _ int) bool {
+		s = append(s, value)
+		return true
+	})
+	return s
+}
+
+// Clear removes all elements from queue, O(n).
+func (p *priorityQueue[T]) Clear() {
+	for p.data.Len() > 0 {
+		p.data.Pop() // clear slice items to avoid memory leaks
+	}
+}
+
+// Drain removes the highest-priority elements and calls fn for each of them and its priority
+// until fn returns false or queue becomes empty, O(k*log(n)).
+// Element for which fn returns false is removed too.
+func (p *priorityQueue[T]) Drain(fn func(value T, priority int) bool) {
+	for p.data.Len() > 0 {
+		item := heap.Pop(p.data).(*PriorityItem[T])
+		if !fn(item.value, item.priority) {
+			return
+		}
+	}
+}
+
+// mergeByPopping pops all elements of other and pushes them into p.
+func mergeByPopping[T any](p, other PriorityQueue[T]) {
+	if p == other {
+		return
+	}
+
+	for other.Len() > 0 {
+		value, priority, _ := other.PopFront()
+		p.Push(value, priority)
+	}
+}
+
 // PriorityItem implements a priority heap item.
 type PriorityItem[T any] struct {
 	value    T
 	priority int
+	index    int // index of item in priority slice
 }
 
+// Value returns value of item.
+func (i PriorityItem[T]) Value() T { return i.value }
+
+// Priority returns priority of item.
+func (i PriorityItem[T]) Priority() int { return i.priority }
+
 // prioritySlice represents heap based on slice.
 // It includes heap.Interface to use container/heap operations.
 type prioritySlice[T any] interface {
 	heap.Interface
 	// First returns first item of priority slice.
 	First() *PriorityItem[T]
+	// Clone returns new priority slice with copies of items.
+	Clone() prioritySlice[T]
+	// merge moves all items of other priority slice to end of slice without restoring heap.
+	// If other has different type, it returns false and does not change slices.
+	merge(other prioritySlice[T]) bool
 }
 
 // minPrioritySlice implements priority slice
@@ -94,6 +211,22 @@ type minPrioritySlice[T any] []*PriorityItem[T]
 // First returns first item of priority slice.
 func (h minPrioritySlice[T]) First() *PriorityItem[T] { return h[0] }
 
+// Clone returns new priority slice with copies of items.
+func (h minPrioritySlice[T]) Clone() prioritySlice[T] {
+	c := h.clone()
+	return &c
+}
+
+// clone returns copy of priority slice with copies of items.
+func (h minPrioritySlice[T]) clone() minPrioritySlice[T] {
+	c := make(minPrioritySlice[T], len(h))
+	for i, item := range h {
+		copied := *item
+		c[i] = &copied
+	}
+	return c
+}
+
 // Len returns number of items contained in priority slice.
 func (h minPrioritySlice[T]) Len() int { return len(h) }
 
@@ -102,10 +235,35 @@ func (h minPrioritySlice[T]) Len() int { return len(h) }
 func (h minPrioritySlice[T]) Less(i, j int) bool { return h[i].priority < h[j].priority }
 
 // Swap swaps priority items with indexes i and j.
-func (h minPrioritySlice[T]) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h minPrioritySlice[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
 
 // Push inserts new priority item with value x at end of slice.
-func (h *minPrioritySlice[T]) Push(x any) { *h = append(*h, x.(*PriorityItem[T])) }
+func (h *minPrioritySlice[T]) Push(x any) {
+	item := x.(*PriorityItem[T])
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+// merge moves all items of other min priority slice to end of slice without restoring heap.
+// If other has different type, it returns false and does not change slices.
+func (h *minPrioritySlice[T]) merge(other prioritySlice[T]) bool {
+	o, ok := other.(*minPrioritySlice[T])
+	if ok {
+		h.append(o)
+	}
+	return ok
+}
+
+// append moves all items of other to end of slice without restoring heap.
+func (h *minPrioritySlice[T]) append(other *minPrioritySlice[T]) {
+	for other.Len() > 0 {
+		h.Push(other.Pop())
+	}
+}
 
 // Pop removes last item from priority slice and returns it.
 func (h *minPrioritySlice[T]) Pop() any {
@@ -124,3 +282,18 @@ type maxPrioritySlice[T any] struct{ minPrioritySlice[T] }
 // Less returns true, if priority of item with index i
 // is greater than priority of item with index j.
 func (h maxPrioritySlice[T]) Less(i, j int) bool { return h.minPrioritySlice.Less(j, i) }
+
+// Clone returns new priority slice with copies of items.
+func (h maxPrioritySlice[T]) Clone() prioritySlice[T] {
+	return &maxPrioritySlice[T]{h.minPrioritySlice.clone()}
+}
+
+// merge moves all items of other max priority slice to end of slice without restoring heap.
+// If other has different type, it returns false and does not change slices.
+func (h *maxPrioritySlice[T]) merge(other prioritySlice[T]) bool {
+	o, ok := other.(*maxPrioritySlice[T])
+	if ok {
+		h.minPrioritySlice.append(&o.minPrioritySlice)
+	}
+	return ok
+}
@@ -1,7 +1,9 @@
 package queue
 
 import (
+	"math/rand"
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -314,3 +316,275 @@ func Test_maxPrioritySlice_Less(t *testing.T) {
 		})
 	}
 }
+
+// testPriorityQueue checks that priority queues created by newMin and newMax
+// pop elements in order of priority.
+func testPriorityQueue(t *testing.T, newMin, newMax func() PriorityQueue[int]) {
+	t.Run("EmptyQueue", func(t *testing.T) {
+		p := newMin()
+		if got := p.Len(); got != 0 {
+			t.Errorf("PriorityQueue.Len() = %v, want %v", got, 0)
+		}
+		if _, _, ok := p.Front(); ok {
+			t.Error("PriorityQueue.Front() got2 = true, want false")
+		}
+		if _, _, ok := p.PopFront(); ok {
+			t.Error("PriorityQueue.PopFront() got2 = true, want false")
+		}
+	})
+
+	tests := []struct {
+		name string
+		new  func() PriorityQueue[int]
+		cmp  func(a, b int) int
+	}{
+		{"MinQueue", newMin, func(a, b int) int { return a - b }},
+		{"MaxQueue", newMax, func(a, b int) int { return b - a }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.new()
+			want := make([]int, 0, 1000)
+			for i := range 1000 {
+				priority := rand.Intn(100)
+				p.Push(i, priority)
+				want = append(want, priority)
+			}
+			slices.SortFunc(want, tt.cmp)
+
+			got := make([]int, 0, len(want))
+			for n := p.Len(); n > 0; n-- {
+				_, front, _ := p.Front()
+				_, priority, _ := p.PopFront()
+				if front != priority {
+					t.Fatalf("PriorityQueue.Front() got1 = %v, PopFront() got1 = %v", front, priority)
+				}
+				if got := p.Len(); got != n-1 {
+					t.Fatalf("PriorityQueue.Len() = %v, want %v", got, n-1)
+				}
+				got = append(got, priority)
+			}
+
+			if !slices.Equal(got, want) {
+				t.Errorf("popped priorities = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+// testMergeablePriorityQueue checks that priority queues created by newMin and newMax
+// contain elements of both queues after merging.
+func testMergeablePriorityQueue(t *testing.T, newMin, newMax func() MergeablePriorityQueue[int]) {
+	fill := func(p PriorityQueue[int], priorities ...int) PriorityQueue[int] {
+		for _, priority := range priorities {
+			p.Push(priority, priority)
+		}
+		return p
+	}
+
+	tests := []struct {
+		name  string
+		p     MergeablePriorityQueue[int]
+		other PriorityQueue[int]
+		want  []int
+	}{
+		{"EmptyQueues", newMin(), newMin(), []int{2, 4}},
+		{"SameQueues", newMin(), fill(newMin(), 5, 1, 3), []int{1, 2, 3, 4, 5}},
+		{"SameMaxQueues", newMax(), fill(newMax(), 5, 1, 3), []int{5, 4, 3, 2, 1}},
+		{"OppositeQueues", newMin(), fill(newMax(), 5, 1, 3), []int{1, 2, 3, 4, 5}},
+		{"BinaryQueue", newMax(), fill(NewMaxPriorityQueue[int](), 5, 1, 3), []int{5, 4, 3, 2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fill(tt.p, 4, 2)
+			tt.p.Merge(tt.other)
+			if got := tt.other.Len(); got != 0 {
+				t.Errorf("other.Len() = %v after Merge(), want %v", got, 0)
+			}
+
+			got := make([]int, 0, tt.p.Len())
+			for tt.p.Len() > 0 {
+				_, priority, _ := tt.p.PopFront()
+				got = append(got, priority)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("popped priorities = %v after Merge(), want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("LargeQueues", func(t *testing.T) {
+		p, other := newMin(), newMin()
+		for range 1500 {
+			priority := rand.Intn(100)
+			p.Push(priority, priority)
+			priority = rand.Intn(100)
+			other.Push(priority, priority)
+		}
+		p.Merge(other)
+
+		got := make([]int, 0, p.Len())
+		for p.Len() > 0 {
+			_, priority, _ := p.PopFront()
+			got = append(got, priority)
+		}
+		if len(got) != 3000 || !slices.IsSorted(got) {
+			t.Errorf("popped %v priorities after Merge(), want %v sorted priorities", len(got), 3000)
+		}
+	})
+
+	t.Run("SelfMerge", func(t *testing.T) {
+		p := newMin()
+		fill(p, 2, 1)
+		p.Merge(p)
+		if got := p.Len(); got != 2 {
+			t.Errorf("PriorityQueue.Len() = %v after Merge(), want %v", got, 2)
+		}
+	})
+}
+
+// benchmarkPriorityQueue measures pushing n elements into queue and popping them.
+func benchmarkPriorityQueue(b *testing.B, newQueue func() PriorityQueue[int]) {
+	const n = 1e4
+	priorities := make([]int, n)
+	for i := range priorities {
+		priorities[i] = rand.Intn(n)
+	}
+
+	b.ResetTimer()
+	for range b.N {
+		p := newQueue()
+		for i, priority := range priorities {
+			p.Push(i, priority)
+		}
+		for p.Len() > 0 {
+			p.PopFront()
+		}
+	}
+}
+
+func TestPriorityQueue_Order(t *testing.T) {
+	testPriorityQueue(t,
+		func() PriorityQueue[int] { return NewMinPriorityQueue[int]() },
+		func() PriorityQueue[int] { return NewMaxPriorityQueue[int]() },
+	)
+}
+
+func BenchmarkPriorityQueue(b *testing.B) {
+	benchmarkPriorityQueue(b, func() PriorityQueue[int] { return NewMinPriorityQueue[int]() })
+}
+
+func TestPriorityQueue_Range(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     IterablePriorityQueue[int]
+		limit int
+		want  []int
+	}{
+		{"EmptyQueue", NewMinPriorityQueue[int](), 10, nil},
+		{"MinQueue", NewMinPriorityQueue[int](), 10, []int{3, 1, 2}},
+		{"MaxQueue", NewMaxPriorityQueue[int](), 10, []int{2, 1, 3}},
+		{"Stopped", NewMaxPriorityQueue[int](), 2, []int{2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.want != nil {
+				tt.p.Push(1, 2)
+				tt.p.Push(2, 3)
+				tt.p.Push(3, 1)
+			}
+
+			var got []int
+			tt.p.Range(func(value, _ int) bool {
+				got = append(got, value)
+				return len(got) < tt.limit
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PriorityQueue.Range() = %v, want %v", got, tt.want)
+			}
+			if got := tt.p.Len(); got != len(tt.p.ToSlice()) || (tt.want != nil && got != 3) {
+				t.Errorf("PriorityQueue.Len() = %v after Range(), want %v", got, 3)
+			}
+		})
+	}
+}
+
+func TestPriorityQueue_ToSlice(t *testing.T) {
+	p := NewMinPriorityQueue[int]()
+	for i := range 100 {
+		p.Push(i, (i*37)%100)
+	}
+
+	got := p.ToSlice()
+	for i := 1; i < len(got); i++ {
+		if (got[i-1]*37)%100 > (got[i]*37)%100 {
+			t.Fatalf("PriorityQueue.ToSlice() = %v is not in priority order", got)
+		}
+	}
+	if value, _, _ := p.Front(); len(got) != 100 || got[0] != value {
+		t.Errorf("PriorityQueue.ToSlice() = %v, want 100 elements starting from %v", got, value)
+	}
+}
+
+func TestPriorityQueue_Drain(t *testing.T) {
+	p := NewMaxPriorityQueue[int]()
+	p.Push(1, 2)
+	p.Push(2, 3)
+	p.Push(3, 1)
+
+	var got []int
+	p.Drain(func(value, priority int) bool {
+		got = append(got, value)
+		return priority > 2
+	})
+	if want := []int{2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PriorityQueue.Drain() = %v, want %v", got, want)
+	}
+	if got, want := p.ToSlice(), []int{3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PriorityQueue.ToSlice() = %v after Drain(), want %v", got, want)
+	}
+
+	p.Clear()
+	if got := p.Len(); got != 0 {
+		t.Errorf("PriorityQueue.Len() = %v after Clear(), want %v", got, 0)
+	}
+}
+
+func TestPriorityQueue_Merge(t *testing.T) {
+	testMergeablePriorityQueue(t,
+		func() MergeablePriorityQueue[int] { return NewMinPriorityQueue[int]() },
+		func() MergeablePriorityQueue[int] { return NewMaxPriorityQueue[int]() },
+	)
+
+	// Heaps with different orders are merged by popping.
+	tests := []struct {
+		name  string
+		p     MergeablePriorityQueue[int]
+		other PriorityQueue[int]
+		want  []int
+	}{
+		{"MinMax", NewMinPriorityQueue[int](), NewMaxPriorityQueue[int](), []int{1, 2, 3, 4}},
+		{"MaxMin", NewMaxPriorityQueue[int](), NewMinPriorityQueue[int](), []int{4, 3, 2, 1}},
+		{"MinStable", NewMinPriorityQueue[int](), NewStableMinPriorityQueue[int](), []int{1, 2, 3, 4}},
+		{"StableMax", NewStableMaxPriorityQueue[int](), NewMaxPriorityQueue[int](), []int{4, 3, 2, 1}},
+		{"StableMinMax", NewStableMinPriorityQueue[int](), NewStableMaxPriorityQueue[int](), []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.p.Push(1, 1)
+			tt.p.Push(4, 4)
+			tt.other.Push(3, 3)
+			tt.other.Push(2, 2)
+			tt.p.Merge(tt.other)
+
+			var got []int
+			for tt.p.Len() > 0 {
+				value, _, _ := tt.p.PopFront()
+				got = append(got, value)
+			}
+			if !reflect.DeepEqual(got, tt.want) || tt.other.Len() != 0 {
+				t.Errorf("popped %v after Merge(), want %v", got, tt.want)
+			}
+		})
+	}
+}
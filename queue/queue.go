@@ -33,13 +33,31 @@ type Queue[T any] interface {
 	PushBack(value T) T
 }
 
+// IterableQueue represents abstract queue that supports iteration and bulk operations.
+type IterableQueue[T any] interface {
+	Queue[T]
+	// Range calls fn for each element of queue from front to back until fn returns false.
+	Range(fn func(value T) bool)
+	// RangeBack calls fn for each element of queue from back to front until fn returns false.
+	RangeBack(fn func(value T) bool)
+	// ToSlice returns new slice with elements of queue from front to back.
+	ToSlice() []T
+	// Clear removes all elements from queue.
+	Clear()
+	// PushBackAll inserts values at back of queue in specified order.
+	PushBackAll(values ...T)
+	// Drain removes elements from front of queue and calls fn for each of them
+	// until fn returns false or queue becomes empty.
+	Drain(fn func(value T) bool)
+}
+
 // listQueue implements queue based on linked list.
 type listQueue[T any] struct {
 	data list.List[T]
 }
 
 // NewListQueue returns new queue based on linked list.
-func NewListQueue[T any]() Queue[T] { return &listQueue[T]{new(list.CircularLinkedList[T])} }
+func NewListQueue[T any]() IterableQueue[T] { return &listQueue[T]{new(list.CircularLinkedList[T])} }
 
 // Len returns number of elements contained in queue, O(1).
 func (q listQueue[T]) Len() int { return q.data.Len() }
@@ -59,3 +77,56 @@ func (q *listQueue[T]) PopFront() (T, bool) { return nodeValue(q.data.PopFront()
 // PushBack inserts new value at back of queue, O(1).
 // It returns the inserted value.
 func (q *listQueue[T]) PushBack(value T) T { return q.data.PushBack(value).Value }
+
+// Range calls fn for each element of queue from front to back until fn returns false, O(n).
+func (q listQueue[T]) Range(fn func(value T) bool) {
+	for node := q.data.Front(); node != nil; node = node.Next() {
+		if !fn(node.Value) {
+			return
+		}
+	}
+}
+
+// RangeBack calls fn for each element of queue from back to front until fn returns false, O(n).
+func (q listQueue[T]) RangeBack(fn func(value T) bool) {
+	for node := q.data.Back(); node != nil; node = node.Prev() {
+		if !fn(node.Value) {
+			return
+		}
+	}
+}
+
+// ToSlice returns new slice with elements of queue from front to back, O(n).
+func (q listQueue[T]) ToSlice() []T {
+	s := make([]T, 0, q.data.Len())
+	q.Range(func(value T) bool {
+		s = append(s, value)
+		return true
+	})
+	return s
+}
+
+// Clear removes all elements from queue, O(n).
+func (q *listQueue[T]) Clear() {
+	for q.data.Len() > 0 {
+		q.data.PopFront() // unlink nodes to avoid memory leaks
+	}
+}
+
+// PushBackAll inserts values at back of queue in specified order, O(k).
+func (q *listQueue[T]) PushBackAll(values ...T) {
+	for _, value := range values {
+		q.data.PushBack(value)
+	}
+}
+
+// Drain removes elements from front of queue and calls fn for each of them
+// until fn returns false or queue becomes empty, O(n).
+// Element for which fn returns false is removed too.
+func (q *listQueue[T]) Drain(fn func(value T) bool) {
+	for q.data.Len() > 0 {
+		if !fn(q.data.PopFront().Value) {
+			return
+		}
+	}
+}
@@ -166,3 +166,100 @@ func TestQueue_PushBack(t *testing.T) {
 		})
 	}
 }
+
+// iterableQueue returns new queue with specified elements.
+func iterableQueue(values ...int) IterableQueue[int] {
+	q := NewListQueue[int]()
+	q.PushBackAll(values...)
+	return q
+}
+
+// collectRange calls rangeFn with function that collects at most limit elements.
+func collectRange(rangeFn func(fn func(value int) bool), limit int) []int {
+	var s []int
+	rangeFn(func(value int) bool {
+		s = append(s, value)
+		return len(s) < limit
+	})
+	return s
+}
+
+func TestQueue_Range(t *testing.T) {
+	tests := []struct {
+		name  string
+		q     IterableQueue[int]
+		limit int
+		want  []int
+		want1 []int
+	}{
+		{"EmptyQueue", iterableQueue(), 10, nil, nil},
+		{"SimpleQueue", iterableQueue(1, 2, 3), 10, []int{1, 2, 3}, []int{3, 2, 1}},
+		{"Stopped", iterableQueue(1, 2, 3), 2, []int{1, 2}, []int{3, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := collectRange(tt.q.Range, tt.limit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Queue.Range() = %v, want %v", got, tt.want)
+			}
+			if got := collectRange(tt.q.RangeBack, tt.limit); !reflect.DeepEqual(got, tt.want1) {
+				t.Errorf("Queue.RangeBack() = %v, want %v", got, tt.want1)
+			}
+			if got := tt.q.Len(); got != len(tt.q.ToSlice()) {
+				t.Errorf("Queue.Len() = %v after Range(), want %v", got, len(tt.q.ToSlice()))
+			}
+		})
+	}
+}
+
+func TestQueue_ToSlice(t *testing.T) {
+	tests := []struct {
+		name string
+		q    IterableQueue[int]
+		want []int
+	}{
+		{"EmptyQueue", iterableQueue(), []int{}},
+		{"SimpleQueue", iterableQueue(1, 2, 3), []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.q.ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Queue.ToSlice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueue_Clear(t *testing.T) {
+	q := iterableQueue(1, 2, 3)
+	q.Clear()
+	if got := q.Len(); got != 0 {
+		t.Errorf("Queue.Len() = %v after Clear(), want %v", got, 0)
+	}
+	if _, ok := q.Front(); ok {
+		t.Errorf("Queue.Front() got1 = %v after Clear(), want %v", ok, false)
+	}
+}
+
+func TestQueue_Drain(t *testing.T) {
+	tests := []struct {
+		name  string
+		q     IterableQueue[int]
+		limit int
+		want  []int
+		want1 []int
+	}{
+		{"EmptyQueue", iterableQueue(), 10, nil, []int{}},
+		{"SimpleQueue", iterableQueue(1, 2, 3), 10, []int{1, 2, 3}, []int{}},
+		{"Stopped", iterableQueue(1, 2, 3), 2, []int{1, 2}, []int{3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := collectRange(tt.q.Drain, tt.limit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Queue.Drain() = %v, want %v", got, tt.want)
+			}
+			if got := tt.q.ToSlice(); !reflect.DeepEqual(got, tt.want1) {
+				t.Errorf("Queue.ToSlice() = %v after Drain(), want %v", got, tt.want1)
+			}
+		})
+	}
+}
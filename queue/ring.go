@@ -0,0 +1,112 @@
+package queue
+
+// minRingCap is initial capacity of ring buffer.
+const minRingCap = 8
+
+// ringQueue implements queue based on growable ring buffer.
+// Capacity of buffer is always power of two.
+type ringQueue[T any] struct {
+	buf  []T
+	head int // index of the first element
+	len  int
+}
+
+// NewRingQueue returns new queue based on growable ring buffer.
+func NewRingQueue[T any]() Queue[T] { return new(ringQueue[T]) }
+
+// Len returns number of elements contained in queue, O(1).
+func (q ringQueue[T]) Len() int { return q.len }
+
+// Front returns first element of queue, O(1).
+// If queue is empty, it returns default value of type T and false as second value.
+func (q ringQueue[T]) Front() (T, bool) {
+	if q.len > 0 {
+		return q.buf[q.head], true
+	}
+
+	var value T
+	return value, false
+}
+
+// Back returns last element of queue, O(1).
+// If queue is empty, it returns default value of type T and false as second value.
+func (q ringQueue[T]) Back() (T, bool) {
+	if q.len > 0 {
+		return q.buf[q.index(q.len-1)], true
+	}
+
+	var value T
+	return value, false
+}
+
+// PopFront removes first element from queue and returns it, O(1).
+// If queue is empty, it returns default value of type T and false as second value.
+func (q *ringQueue[T]) PopFront() (T, bool) {
+	var zero T
+	if q.len == 0 {
+		return zero, false
+	}
+
+	value := q.buf[q.head]
+	q.buf[q.head] = zero // avoid memory leaks
+	q.head = q.index(1)
+	q.len--
+	return value, true
+}
+
+// PushBack inserts new value at back of queue, amortized O(1).
+// It returns the inserted value.
+func (q *ringQueue[T]) PushBack(value T) T {
+	q.grow()
+	q.buf[q.index(q.len)] = value
+	q.len++
+	return value
+}
+
+// index returns buffer index of element with offset i from the first element.
+func (q ringQueue[T]) index(i int) int { return (q.head + i) & (len(q.buf) - 1) }
+
+// grow doubles buffer capacity if buffer is full, O(n).
+// Elements are moved to the beginning of new buffer.
+func (q *ringQueue[T]) grow() {
+	if q.len < len(q.buf) {
+		return
+	}
+
+	buf := make([]T, max(2*len(q.buf), minRingCap))
+	n := copy(buf, q.buf[q.head:])
+	copy(buf[n:], q.buf[:q.head])
+	q.buf = buf
+	q.head = 0
+}
+
+// ringDeque implements double-ended queue based on growable ring buffer.
+type ringDeque[T any] struct{ *ringQueue[T] }
+
+// NewRingDeque returns new deque based on growable ring buffer.
+func NewRingDeque[T any]() Deque[T] { return &ringDeque[T]{new(ringQueue[T])} }
+
+// PopBack removes last element from queue and returns it, O(1).
+// If queue is empty, it returns default value of type T and false as second value.
+func (d *ringDeque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.len == 0 {
+		return zero, false
+	}
+
+	i := d.index(d.len - 1)
+	value := d.buf[i]
+	d.buf[i] = zero // avoid memory leaks
+	d.len--
+	return value, true
+}
+
+// PushFront inserts new value at front of queue, amortized O(1).
+// It returns the inserted value.
+func (d *ringDeque[T]) PushFront(value T) T {
+	d.grow()
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = value
+	d.len++
+	return value
+}
@@ -0,0 +1,225 @@
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func emptyRingDeque() Deque[int] { return NewRingDeque[int]() }
+
+func simpleRingDeque() Deque[int] {
+	d := NewRingDeque[int]()
+	d.PushBack(2)
+	d.PushFront(1)
+	return d
+}
+
+func TestNewRingQueue(t *testing.T) {
+	tests := []struct {
+		name string
+		want Queue[int]
+	}{
+		{"EmptyQueue", new(ringQueue[int])},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRingQueue[int](); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewRingQueue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRingDeque(t *testing.T) {
+	tests := []struct {
+		name string
+		want Deque[int]
+	}{
+		{"EmptyQueue", &ringDeque[int]{new(ringQueue[int])}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRingDeque[int](); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewRingDeque() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRingDeque_Front(t *testing.T) {
+	tests := []struct {
+		name  string
+		d     Deque[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyRingDeque(), 0, false},
+		{"SimpleQueue", simpleRingDeque(), 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.d.Front()
+			if got != tt.want {
+				t.Errorf("Deque.Front() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("Deque.Front() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestRingDeque_Back(t *testing.T) {
+	tests := []struct {
+		name  string
+		d     Deque[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyRingDeque(), 0, false},
+		{"SimpleQueue", simpleRingDeque(), 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.d.Back()
+			if got != tt.want {
+				t.Errorf("Deque.Back() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("Deque.Back() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestRingDeque_PopFront(t *testing.T) {
+	tests := []struct {
+		name  string
+		d     Deque[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyRingDeque(), 0, false},
+		{"SimpleQueue", simpleRingDeque(), 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.d.PopFront()
+			if got != tt.want {
+				t.Errorf("Deque.PopFront() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("Deque.PopFront() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestRingDeque_PopBack(t *testing.T) {
+	tests := []struct {
+		name  string
+		d     Deque[int]
+		want  int
+		want1 bool
+	}{
+		{"EmptyQueue", emptyRingDeque(), 0, false},
+		{"SimpleQueue", simpleRingDeque(), 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := tt.d.PopBack()
+			if got != tt.want {
+				t.Errorf("Deque.PopBack() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("Deque.PopBack() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func FuzzRingDeque(f *testing.F) {
+	for range 100 {
+		b := make([]byte, 200)
+		for i := range b {
+			b[i] = byte(rand.Intn(256))
+		}
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		d := NewRingDeque[int]()
+		var want []int // elements expected to be contained in deque
+
+		// Each byte is operation applied to both deque and slice.
+		for i, op := range b {
+			var got, wantValue int
+			var ok, wantOk bool
+			switch op % 6 {
+			case 0:
+				got, ok = d.PopFront()
+				if wantOk = len(want) > 0; wantOk {
+					wantValue, want = want[0], want[1:]
+				}
+			case 1:
+				got, ok = d.PopBack()
+				if wantOk = len(want) > 0; wantOk {
+					wantValue, want = want[len(want)-1], want[:len(want)-1]
+				}
+			case 2:
+				got, ok = d.Front()
+				if wantOk = len(want) > 0; wantOk {
+					wantValue = want[0]
+				}
+			case 3:
+				got, ok = d.Back()
+				if wantOk = len(want) > 0; wantOk {
+					wantValue = want[len(want)-1]
+				}
+			case 4:
+				got, ok = d.PushFront(i), true
+				wantValue, wantOk, want = i, true, append([]int{i}, want...)
+			default:
+				got, ok = d.PushBack(i), true
+				wantValue, wantOk, want = i, true, append(want, i)
+			}
+
+			if got != wantValue || ok != wantOk {
+				t.Fatalf("operation %v: got (%v, %v), want (%v, %v)", op%6, got, ok, wantValue, wantOk)
+			}
+			if d.Len() != len(want) {
+				t.Fatalf("Deque.Len() = %v, want %v", d.Len(), len(want))
+			}
+		}
+	})
+}
+
+// benchmarkQueue measures pushing n elements into queue and popping them.
+func benchmarkQueue(b *testing.B, newQueue func() Queue[int]) {
+	const n = 1e4
+	for range b.N {
+		q := newQueue()
+		for i := range int(n) {
+			q.PushBack(i)
+		}
+		for q.Len() > 0 {
+			q.PopFront()
+		}
+	}
+}
+
+func BenchmarkListQueue(b *testing.B) {
+	benchmarkQueue(b, func() Queue[int] { return NewListQueue[int]() })
+}
+
+func BenchmarkRingQueue(b *testing.B) {
+	benchmarkQueue(b, NewRingQueue[int])
+}
+
+func BenchmarkListDeque(b *testing.B) {
+	benchmarkQueue(b, func() Queue[int] { return NewListDeque[int]() })
+}
+
+func BenchmarkRingDeque(b *testing.B) {
+	benchmarkQueue(b, func() Queue[int] { return NewRingDeque[int]() })
+}
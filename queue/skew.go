@@ -0,0 +1,118 @@
+package queue
+
+// skewNode implements a skew heap node.
+type skewNode[T any] struct {
+	value    T
+	priority int
+	left     *skewNode[T]
+	right    *skewNode[T]
+}
+
+// skewPriorityQueue implements priority queue based on skew min/max heap.
+// Element with the highest priority is the root of heap.
+// Skew heap is self-adjusting leftist heap that doesn't store ranks.
+type skewPriorityQueue[T any] struct {
+	root *skewNode[T]
+	len  int
+	max  bool // if true, maximum priority value is the highest priority
+}
+
+// NewMinSkewPriorityQueue returns new priority queue based on skew min heap.
+func NewMinSkewPriorityQueue[T any]() MergeablePriorityQueue[T] {
+	return new(skewPriorityQueue[T])
+}
+
+// NewMaxSkewPriorityQueue returns new priority queue based on skew max heap.
+func NewMaxSkewPriorityQueue[T any]() MergeablePriorityQueue[T] {
+	return &skewPriorityQueue[T]{max: true}
+}
+
+// Len returns number of elements contained in queue, O(1).
+func (p skewPriorityQueue[T]) Len() int { return p.len }
+
+// Front returns element of queue that has the highest priority in heap, O(1).
+// It also returns element's priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p skewPriorityQueue[T]) Front() (T, int, bool) {
+	if p.root != nil {
+		return p.root.value, p.root.priority, true
+	}
+
+	var value T
+	return value, 0, false
+}
+
+// PopFront removes element of queue that has the highest priority in heap, amortized O(log(n)).
+// It returns this element and its priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p *skewPriorityQueue[T]) PopFront() (T, int, bool) {
+	root := p.root
+	if root == nil {
+		var value T
+		return value, 0, false
+	}
+
+	p.root = p.meld(root.left, root.right)
+	p.len--
+
+	// Avoid memory leaks.
+	root.left = nil
+	root.right = nil
+	return root.value, root.priority, true
+}
+
+// Push inserts new value with priority into queue, amortized O(log(n)).
+// It returns the inserted value and its priority.
+func (p *skewPriorityQueue[T]) Push(value T, priority int) (T, int) {
+	p.root = p.meld(p.root, &skewNode[T]{value: value, priority: priority})
+	p.len++
+	return value, priority
+}
+
+// Merge moves all elements of other into queue.
+// If other is skew heap with the same order, complexity is amortized O(log(n+m)),
+// otherwise elements are popped from other and pushed into queue one by one.
+// other becomes empty after merging.
+func (p *skewPriorityQueue[T]) Merge(other PriorityQueue[T]) {
+	o, ok := other.(*skewPriorityQueue[T])
+	if !ok || o.max != p.max {
+		mergeByPopping(p, other)
+		return
+	}
+	if o == p {
+		return
+	}
+
+	p.root = p.meld(p.root, o.root)
+	p.len += o.len
+	o.root = nil
+	o.len = 0
+}
+
+// meld merges two heaps along their right spines and returns root of the resulting heap.
+// Children of each node on merge path are swapped.
+// It is iterative, since right spine of skew heap can be long.
+func (p *skewPriorityQueue[T]) meld(a, b *skewNode[T]) *skewNode[T] {
+	var root *skewNode[T]
+	link := &root // where the next node of merge path is attached
+	for a != nil && b != nil {
+		if higher(p.max, b.priority, a.priority) {
+			a, b = b, a
+		}
+
+		// Right subtree of a is merged with b and becomes its left subtree.
+		next := a.right
+		a.right = a.left
+		a.left = nil
+		*link = a
+		link = &a.left
+		a = next
+	}
+
+	if a != nil {
+		*link = a
+	} else {
+		*link = b
+	}
+	return root
+}
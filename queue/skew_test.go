@@ -0,0 +1,18 @@
+package queue
+
+import "testing"
+
+func TestSkewPriorityQueue(t *testing.T) {
+	testPriorityQueue(t,
+		func() PriorityQueue[int] { return NewMinSkewPriorityQueue[int]() },
+		func() PriorityQueue[int] { return NewMaxSkewPriorityQueue[int]() },
+	)
+}
+
+func TestSkewPriorityQueue_Merge(t *testing.T) {
+	testMergeablePriorityQueue(t, NewMinSkewPriorityQueue[int], NewMaxSkewPriorityQueue[int])
+}
+
+func BenchmarkSkewPriorityQueue(b *testing.B) {
+	benchmarkPriorityQueue(b, func() PriorityQueue[int] { return NewMinSkewPriorityQueue[int]() })
+}
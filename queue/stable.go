@@ -0,0 +1,106 @@
+package queue
+
+import "cmp"
+
+// stablePriorityQueue implements priority queue based on stable heap ordered by priorities.
+// Elements with equal priorities are popped in order of insertion.
+type stablePriorityQueue[T any] struct {
+	funcPriorityQueue[PriorityItem[T]]
+	max bool // if true, maximum priority value is the highest priority
+}
+
+// NewStableMinPriorityQueue returns new priority queue based on min heap.
+// Elements with equal priorities are popped in order of insertion.
+func NewStableMinPriorityQueue[T any]() MergeableIterablePriorityQueue[T] {
+	return &stablePriorityQueue[T]{newFuncPriorityQueue(comparePriorities[T], true), false}
+}
+
+// NewStableMaxPriorityQueue returns new priority queue based on max heap.
+// Elements with equal priorities are popped in order of insertion.
+func NewStableMaxPriorityQueue[T any]() MergeableIterablePriorityQueue[T] {
+	cmp := func(a, b PriorityItem[T]) int { return comparePriorities(b, a) }
+	return &stablePriorityQueue[T]{newFuncPriorityQueue(cmp, true), true}
+}
+
+// comparePriorities returns result of comparison of priorities of a and b.
+func comparePriorities[T any](a, b PriorityItem[T]) int { return cmp.Compare(a.priority, b.priority) }
+
+// Front returns element of queue that has the highest priority in heap, O(1).
+// It also returns element's priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p stablePriorityQueue[T]) Front() (T, int, bool) {
+	item, ok := p.funcPriorityQueue.Front()
+	return item.value, item.priority, ok
+}
+
+// PopFront removes element of queue that has the highest priority in heap, O(log(n)).
+// It returns this element and its priority as second value.
+// If queue is empty, it returns default value of type T and false as third value.
+func (p *stablePriorityQueue[T]) PopFront() (T, int, bool) {
+	item, ok := p.funcPriorityQueue.PopFront()
+	return item.value, item.priority, ok
+}
+
+// Push inserts new value with priority into queue, O(log(n)).
+// It returns the inserted value and its priority.
+func (p *stablePriorityQueue[T]) Push(value T, priority int) (T, int) {
+	p.funcPriorityQueue.Push(PriorityItem[T]{value: value, priority: priority})
+	return value, priority
+}
+
+// Merge moves all elements of other into queue.
+// If other is stable heap with the same order, items are appended and heap is rebuilt in O(n+m),
+// otherwise elements are popped from other and pushed into queue one by one.
+// Merged elements follow elements of queue with equal priorities.
+// other becomes empty after merging.
+func (p *stablePriorityQueue[T]) Merge(other PriorityQueue[T]) {
+	o, ok := other.(*stablePriorityQueue[T])
+	if o == p {
+		return
+	}
+	if !ok || o.max != p.max {
+		mergeByPopping(p, other)
+		return
+	}
+
+	p.data.merge(o.data)
+}
+
+// Range calls fn for each element and its priority
+// from the highest to the lowest priority until fn returns false, O(n*log(n)).
+// Queue is not modified, elements are popped from its copy.
+func (p stablePriorityQueue[T]) Range(fn func(value T, priority int) bool) {
+	data := funcPriorityQueue[PriorityItem[T]]{p.data.clone()}
+	for {
+		item, ok := data.PopFront()
+		if !ok || !fn(item.value, item.priority) {
+			return
+		}
+	}
+}
+
+// ToSlice returns new slice with elements of queue
+// from the highest to the lowest priority, O(n*log(n)).
+func (p stablePriorityQueue[T]) ToSlice() []T {
+	s := make([]T, 0, p.Len())
+	p.Range(func(value T, _ int) bool {
+		s = append(s, value)
+		return true
+	})
+	return s
+}
+
+// Clear removes all elements from queue, O(n).
+func (p *stablePriorityQueue[T]) Clear() { p.data.clear() }
+
+// Drain removes the highest-priority elements and calls fn for each of them and its priority
+// until fn returns false or queue becomes empty, O(k*log(n)).
+// Element for which fn returns false is removed too.
+func (p *stablePriorityQueue[T]) Drain(fn func(value T, priority int) bool) {
+	for {
+		value, priority, ok := p.PopFront()
+		if !ok || !fn(value, priority) {
+			return
+		}
+	}
+}
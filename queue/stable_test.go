@@ -0,0 +1,143 @@
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestStablePriorityQueue_Order(t *testing.T) {
+	testPriorityQueue(t,
+		func() PriorityQueue[int] { return NewStableMinPriorityQueue[int]() },
+		func() PriorityQueue[int] { return NewStableMaxPriorityQueue[int]() },
+	)
+}
+
+// stableTests contains stable priority queues with functions
+// that return true if priority a precedes priority b.
+var stableTests = []struct {
+	name   string
+	new    func() MergeableIterablePriorityQueue[int]
+	before func(a, b int) bool
+}{
+	{"MinQueue", NewStableMinPriorityQueue[int], func(a, b int) bool { return a < b }},
+	{"MaxQueue", NewStableMaxPriorityQueue[int], func(a, b int) bool { return a > b }},
+}
+
+func TestStablePriorityQueue_Ties(t *testing.T) {
+	for _, tt := range stableTests {
+		for _, priorities := range []int{1, 2, 3, 10} {
+			t.Run(fmt.Sprintf("%v/%vPriorities", tt.name, priorities), func(t *testing.T) {
+				r := rand.New(rand.NewSource(int64(priorities)))
+				p := tt.new()
+				var want []PriorityItem[int] // elements in order of insertion
+
+				// Pop removes the first inserted element with the highest priority from want.
+				pop := func() PriorityItem[int] {
+					best := 0
+					for i, item := range want {
+						if tt.before(item.priority, want[best].priority) {
+							best = i
+						}
+					}
+					item := want[best]
+					want = slices.Delete(want, best, best+1)
+					return item
+				}
+
+				for i := range 10000 {
+					if r.Intn(3) > 0 || len(want) == 0 {
+						priority := r.Intn(priorities)
+						p.Push(i, priority)
+						want = append(want, PriorityItem[int]{value: i, priority: priority})
+						continue
+					}
+
+					wantItem := pop()
+					value, priority, ok := p.PopFront()
+					if !ok || value != wantItem.value || priority != wantItem.priority {
+						t.Fatalf("PriorityQueue.PopFront() = (%v, %v, %v), want (%v, %v, %v)",
+							value, priority, ok, wantItem.value, wantItem.priority, true)
+					}
+				}
+
+				// Range and ToSlice must follow the same order as popping.
+				got := p.ToSlice()
+				var ranged []int
+				p.Range(func(value, _ int) bool {
+					ranged = append(ranged, value)
+					return true
+				})
+				for i := range got {
+					wantItem := pop()
+					if got[i] != wantItem.value || ranged[i] != wantItem.value {
+						t.Fatalf("PriorityQueue.ToSlice()[%v] = %v, Range() = %v, want %v", i, got[i], ranged[i], wantItem.value)
+					}
+					if value, _, _ := p.PopFront(); value != wantItem.value {
+						t.Fatalf("PriorityQueue.PopFront() = %v, want %v", value, wantItem.value)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestStablePriorityQueue_EqualPriorities(t *testing.T) {
+	for _, tt := range stableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.new()
+			for i := range 1000 {
+				p.Push(i, 0)
+			}
+
+			var got []int
+			p.Drain(func(value, _ int) bool {
+				got = append(got, value)
+				return true
+			})
+			for i, value := range got {
+				if value != i {
+					t.Fatalf("PriorityQueue.Drain() yields %v at position %v, want %v", value, i, i)
+				}
+			}
+			if len(got) != 1000 {
+				t.Errorf("PriorityQueue.Drain() yields %v elements, want %v", len(got), 1000)
+			}
+		})
+	}
+}
+
+func BenchmarkStablePriorityQueue(b *testing.B) {
+	benchmarkPriorityQueue(b, func() PriorityQueue[int] { return NewStableMinPriorityQueue[int]() })
+}
+
+func TestStablePriorityQueue_Merge(t *testing.T) {
+	testMergeablePriorityQueue(t,
+		func() MergeablePriorityQueue[int] { return NewStableMinPriorityQueue[int]() },
+		func() MergeablePriorityQueue[int] { return NewStableMaxPriorityQueue[int]() },
+	)
+
+	// Elements of merged queue follow elements of queue with equal priorities.
+	p, other := NewStableMinPriorityQueue[int](), NewStableMinPriorityQueue[int]()
+	for i := range 100 {
+		p.Push(i, i%2)
+		other.Push(100+i, i%2)
+	}
+	p.Merge(other)
+
+	var got []int
+	p.Drain(func(value, _ int) bool {
+		got = append(got, value)
+		return true
+	})
+	var want []int
+	for _, priority := range []int{0, 1} {
+		for i := priority; i < 200; i += 2 {
+			want = append(want, i)
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("PriorityQueue.Drain() = %v after Merge(), want %v", got, want)
+	}
+}
@@ -0,0 +1,156 @@
+package queue
+
+import "sync/atomic"
+
+// WorkStealingDeque represents abstract double-ended queue
+// that is owned by single goroutine and can be stolen from by other goroutines.
+// Owner pushes and pops elements at back, thieves pop elements from front.
+type WorkStealingDeque[T any] interface {
+	// Len returns number of elements contained in deque.
+	Len() int
+	// PopFront removes first element from deque and returns it.
+	// It can be called by any goroutine.
+	// If deque is empty, it returns default value of type T and false as second value.
+	PopFront() (T, bool)
+	// PopBack removes last element from deque and returns it.
+	// It must be called only by owner.
+	// If deque is empty, it returns default value of type T and false as second value.
+	PopBack() (T, bool)
+	// PushBack inserts new value at back of deque.
+	// It must be called only by owner.
+	// It returns the inserted value.
+	PushBack(value T) T
+}
+
+// stealingBuffer implements circular buffer of work-stealing deque.
+// Capacity of buffer is always power of two.
+// Elements are stored as pointers, so that thieves can read them atomically.
+type stealingBuffer[T any] struct {
+	data []atomic.Pointer[T]
+}
+
+// get returns element with logical index i.
+func (b *stealingBuffer[T]) get(i int64) *T { return b.data[i&int64(len(b.data)-1)].Load() }
+
+// put stores element with logical index i.
+func (b *stealingBuffer[T]) put(i int64, value *T) { b.data[i&int64(len(b.data)-1)].Store(value) }
+
+// clear removes element with logical index i, if slot still contains value.
+// Slot may already contain new element after owner wraps around buffer.
+func (b *stealingBuffer[T]) clear(i int64, value *T) {
+	b.data[i&int64(len(b.data)-1)].CompareAndSwap(value, nil)
+}
+
+// grow returns new buffer with doubled capacity
+// that contains elements with logical indexes from top to bottom, O(n).
+func (b *stealingBuffer[T]) grow(top, bottom int64) *stealingBuffer[T] {
+	buf := &stealingBuffer[T]{make([]atomic.Pointer[T], 2*len(b.data))}
+	for i := top; i < bottom; i++ {
+		buf.put(i, b.get(i))
+	}
+
+	return buf
+}
+
+// workStealingDeque implements Chase-Lev work-stealing deque based on growable circular buffer.
+// top is logical index of the first element, bottom is logical index after the last element.
+// Old buffers are not reused, so thieves can safely read them after growing.
+type workStealingDeque[T any] struct {
+	top    atomic.Int64
+	bottom atomic.Int64
+	buf    atomic.Pointer[stealingBuffer[T]]
+}
+
+// NewWorkStealingDeque returns new Chase-Lev work-stealing deque.
+func NewWorkStealingDeque[T any]() WorkStealingDeque[T] {
+	d := new(workStealingDeque[T])
+	d.buf.Store(&stealingBuffer[T]{make([]atomic.Pointer[T], minRingCap)})
+	return d
+}
+
+// Len returns number of elements contained in deque, O(1).
+// Under concurrent modification, result is approximate.
+func (d *workStealingDeque[T]) Len() int {
+	return int(max(d.bottom.Load()-d.top.Load(), 0))
+}
+
+// PopFront removes first element from deque and returns it, O(1) without contention.
+// It can be called by any goroutine, it retries if another goroutine takes the same element.
+// If deque is empty, it returns default value of type T and false as second value.
+func (d *workStealingDeque[T]) PopFront() (T, bool) {
+	for {
+		top := d.top.Load()
+		bottom := d.bottom.Load()
+		if top >= bottom {
+			var value T
+			return value, false
+		}
+
+		// Read element before claiming it, since owner may overwrite its slot afterwards.
+		// If top is stale, slot may be empty in grown buffer, then claiming fails anyway.
+		buf := d.buf.Load()
+		value := buf.get(top)
+		if value != nil && d.top.CompareAndSwap(top, top+1) {
+			d.clear(buf, top, value) // avoid memory leaks
+			return *value, true
+		}
+	}
+}
+
+// PopBack removes last element from deque and returns it, O(1).
+// It must be called only by owner.
+// If deque is empty, it returns default value of type T and false as second value.
+func (d *workStealingDeque[T]) PopBack() (T, bool) {
+	// Reserve the last element before checking top, so that thieves don't take it.
+	bottom := d.bottom.Load() - 1
+	buf := d.buf.Load()
+	d.bottom.Store(bottom)
+	top := d.top.Load()
+
+	if top > bottom {
+		// Deque is empty.
+		d.bottom.Store(bottom + 1)
+		var value T
+		return value, false
+	}
+
+	value := buf.get(bottom)
+	if top == bottom {
+		// Deque contains single element, race with thieves for it.
+		ok := d.top.CompareAndSwap(top, top+1)
+		d.bottom.Store(bottom + 1)
+		if !ok {
+			var value T
+			return value, false
+		}
+	}
+
+	d.clear(buf, bottom, value) // avoid memory leaks
+	return *value, true
+}
+
+// clear removes taken element with logical index i from buf and current buffer,
+// since buffer may be grown after element is read.
+func (d *workStealingDeque[T]) clear(buf *stealingBuffer[T], i int64, value *T) {
+	buf.clear(i, value)
+	if cur := d.buf.Load(); cur != buf {
+		cur.clear(i, value)
+	}
+}
+
+// PushBack inserts new value at back of deque, amortized O(1).
+// It must be called only by owner.
+// It returns the inserted value.
+func (d *workStealingDeque[T]) PushBack(value T) T {
+	bottom := d.bottom.Load()
+	top := d.top.Load()
+	buf := d.buf.Load()
+	if bottom-top >= int64(len(buf.data)) {
+		buf = buf.grow(top, bottom)
+		d.buf.Store(buf)
+	}
+
+	buf.put(bottom, &value)
+	d.bottom.Store(bottom + 1)
+	return value
+}
@@ -0,0 +1,107 @@
+package queue
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWorkStealingDeque(t *testing.T) {
+	tests := []struct {
+		name  string
+		op    func(d WorkStealingDeque[int]) (int, bool)
+		want  []int
+		want1 bool
+	}{
+		{"PopFront", WorkStealingDeque[int].PopFront, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, false},
+		{"PopBack", WorkStealingDeque[int].PopBack, []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewWorkStealingDeque[int]()
+			for i := range 10 {
+				if got := d.PushBack(i); got != i {
+					t.Errorf("WorkStealingDeque.PushBack() = %v, want %v", got, i)
+				}
+			}
+			if got := d.Len(); got != 10 {
+				t.Errorf("WorkStealingDeque.Len() = %v, want %v", got, 10)
+			}
+
+			var got []int
+			for range 10 {
+				value, _ := tt.op(d)
+				got = append(got, value)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WorkStealingDeque.%v() got = %v, want %v", tt.name, got, tt.want)
+			}
+			if _, got1 := tt.op(d); got1 != tt.want1 {
+				t.Errorf("WorkStealingDeque.%v() got1 = %v on empty deque, want %v", tt.name, got1, tt.want1)
+			}
+			// Consumed slots must be cleared.
+			buf := d.(*workStealingDeque[int]).buf.Load()
+			for i := range buf.data {
+				if got := buf.data[i].Load(); got != nil {
+					t.Errorf("WorkStealingDeque slot %v = %v after %v(), want nil", i, *got, tt.name)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkStealingDeque_Concurrent(t *testing.T) {
+	const thieves, n = 4, 100000
+	d := NewWorkStealingDeque[int]()
+
+	// Each element must be taken exactly once by owner or thieves.
+	taken := make([]atomic.Int32, n)
+	var done atomic.Bool
+	var wg sync.WaitGroup
+	for range thieves {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !done.Load() || d.Len() > 0 {
+				if value, ok := d.PopFront(); ok {
+					taken[value].Add(1)
+				}
+			}
+		}()
+	}
+
+	// Owner pushes elements in bursts and pops some of them.
+	for i := 0; i < n; {
+		for j := 0; j < 10 && i < n; j++ {
+			d.PushBack(i)
+			i++
+		}
+		for range 3 {
+			if value, ok := d.PopBack(); ok {
+				taken[value].Add(1)
+			}
+		}
+	}
+	done.Store(true)
+	wg.Wait()
+
+	for value := range taken {
+		if got := taken[value].Load(); got != 1 {
+			t.Fatalf("element %v is taken %v times, want %v", value, got, 1)
+		}
+	}
+}
+
+func BenchmarkWorkStealingDeque(b *testing.B) {
+	d := NewWorkStealingDeque[int]()
+	for i := range b.N {
+		d.PushBack(i)
+		if i%2 == 0 {
+			d.PopBack()
+		}
+	}
+	for d.Len() > 0 {
+		d.PopFront()
+	}
+}
@@ -0,0 +1,199 @@
+package queue
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Timer represents a timeout scheduled in timer wheel.
+type Timer[T any] struct {
+	value    T
+	deadline time.Time
+	tick     int64 // tick at which timer expires
+	done     bool  // timer is expired or stopped
+	wheel    *timerWheel[T]
+}
+
+// Value returns value of timer.
+func (t *Timer[T]) Value() T { return t.value }
+
+// Deadline returns deadline of timer.
+func (t *Timer[T]) Deadline() time.Time { return t.deadline }
+
+// Stop prevents timer from expiring, O(1).
+// It returns false if timer has already expired or been stopped.
+// Stopped timer is removed from wheel lazily, when its slot is visited.
+func (t *Timer[T]) Stop() bool {
+	if t.done {
+		return false
+	}
+
+	t.done = true
+	t.wheel.len--
+	return true
+}
+
+// TimerWheel represents abstract hierarchical timer wheel.
+type TimerWheel[T any] interface {
+	// Len returns number of timers that are neither expired nor stopped.
+	Len() int
+	// Add schedules new timer with value and deadline and returns it.
+	Add(value T, deadline time.Time) *Timer[T]
+	// Advance moves wheel to current time
+	// and returns values of expired timers in order of their ticks.
+	Advance() []T
+}
+
+// timerWheel implements hierarchical timer wheel.
+// Each level consists of size slots, slot of level l spans size^l ticks.
+// Timers are cascaded to lower levels when wheel reaches their slots.
+type timerWheel[T any] struct {
+	levels  [][][]*Timer[T]
+	expired []*Timer[T] // timers added with deadline that has already passed
+	size    int64
+	tick    time.Duration
+	start   time.Time
+	cur     int64 // number of the last processed tick
+	len     int
+	now     func() time.Time
+}
+
+// NewTimerWheel returns new timer wheel with specified tick duration,
+// number of slots per level and number of levels.
+// It uses now to get current time. If now is nil, it uses time.Now.
+// Timers expire on the first tick that is not before their deadlines.
+func NewTimerWheel[T any](tick time.Duration, size, levels int, now func() time.Time) TimerWheel[T] {
+	if now == nil {
+		now = time.Now
+	}
+
+	w := &timerWheel[T]{
+		levels: make([][][]*Timer[T], max(levels, 1)),
+		size:   int64(max(size, 2)),
+		tick:   max(tick, 1),
+		start:  now(),
+		now:    now,
+	}
+	for i := range w.levels {
+		w.levels[i] = make([][]*Timer[T], w.size)
+	}
+
+	return w
+}
+
+// Len returns number of timers that are neither expired nor stopped, O(1).
+func (w timerWheel[T]) Len() int { return w.len }
+
+// Add schedules new timer with value and deadline and returns it, O(levels).
+func (w *timerWheel[T]) Add(value T, deadline time.Time) *Timer[T] {
+	d := deadline.Sub(w.start)
+	tick := int64(d / w.tick)
+	if d%w.tick > 0 {
+		tick++
+	}
+
+	t := &Timer[T]{value: value, deadline: deadline, tick: tick, wheel: w}
+	if tick <= w.cur {
+		w.expired = append(w.expired, t)
+	} else {
+		w.place(t)
+	}
+
+	w.len++
+	return t
+}
+
+// Advance moves wheel to current time
+// and returns values of expired timers in order of their ticks, O(ticks + timers*log(timers)).
+func (w *timerWheel[T]) Advance() []T {
+	// Timers added after their deadlines have passed precede timers in slots,
+	// but they are added in arbitrary order.
+	slices.SortStableFunc(w.expired, func(a, b *Timer[T]) int { return cmp.Compare(a.tick, b.tick) })
+
+	var values []T
+	for _, t := range w.expired {
+		values = w.expire(values, t)
+	}
+	w.expired = nil
+
+	target := int64(w.now().Sub(w.start) / w.tick)
+	for w.cur < target {
+		// Skip empty ticks.
+		if w.len == 0 {
+			w.cur = target
+			break
+		}
+
+		w.cur++
+
+		// Cascade timers from reached slots of higher levels, starting from the highest one,
+		// so that cascaded timers can be cascaded further in the same tick.
+		top, span := 0, int64(1)
+		for top < len(w.levels)-1 && w.cur%(span*w.size) == 0 {
+			top++
+			span *= w.size
+		}
+		for l := top; l > 0; l-- {
+			slot := (w.cur / span) % w.size
+			timers := w.levels[l][slot]
+			w.levels[l][slot] = nil
+			for _, t := range timers {
+				if !t.done {
+					w.place(t)
+				}
+			}
+			span /= w.size
+		}
+
+		// Timers of the first level may belong to later rounds, if wheel has single level.
+		slot := w.cur % w.size
+		timers := w.levels[0][slot]
+		w.levels[0][slot] = nil
+		for _, t := range timers {
+			if t.tick > w.cur && !t.done {
+				w.place(t)
+			} else {
+				values = w.expire(values, t)
+			}
+		}
+	}
+
+	return values
+}
+
+// expire marks timer as expired and appends its value to values.
+// If timer is stopped, values are returned unchanged.
+func (w *timerWheel[T]) expire(values []T, t *Timer[T]) []T {
+	if t.done {
+		return values
+	}
+
+	t.done = true
+	w.len--
+	return append(values, t.value)
+}
+
+// place inserts timer into the lowest level that covers its tick.
+// Timer with tick that has been reached is inserted into the current slot of the first level.
+// Timer with tick that is beyond the highest level is inserted into its last slot
+// and re-placed when the slot is reached.
+func (w *timerWheel[T]) place(t *Timer[T]) {
+	tick := max(t.tick, w.cur)
+	span := int64(1)
+	for l := range w.levels {
+		if tick/span-w.cur/span < w.size {
+			slot := (tick / span) % w.size
+			w.levels[l][slot] = append(w.levels[l][slot], t)
+			return
+		}
+
+		if l < len(w.levels)-1 {
+			span *= w.size
+		}
+	}
+
+	top := len(w.levels) - 1
+	slot := (w.cur/span + w.size - 1) % w.size
+	w.levels[top][slot] = append(w.levels[top][slot], t)
+}
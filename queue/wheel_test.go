@@ -0,0 +1,129 @@
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTimerWheel_Advance(t *testing.T) {
+	clock := newFakeClock()
+	w := NewTimerWheel[int](time.Second, 4, 2, clock.Now)
+	w.Add(1, clock.Now().Add(time.Second))
+	w.Add(2, clock.Now().Add(1500*time.Millisecond))
+	w.Add(3, clock.Now().Add(10*time.Second))
+	w.Add(4, clock.Now().Add(100*time.Second))
+	w.Add(0, clock.Now().Add(-time.Second))
+	stopped := w.Add(5, clock.Now().Add(3*time.Second))
+
+	if !stopped.Stop() {
+		t.Errorf("Timer.Stop() = false, want true")
+	}
+	if stopped.Stop() {
+		t.Errorf("Timer.Stop() = true after Stop(), want false")
+	}
+
+	tests := []struct {
+		name    string
+		sleep   time.Duration
+		want    []int
+		wantLen int
+	}{
+		{"Expired", 0, []int{0}, 4},
+		{"FirstTick", time.Second, []int{1}, 3},
+		{"SecondTick", time.Second, []int{2}, 2},
+		{"Cascaded", 8 * time.Second, []int{3}, 1},
+		{"Nothing", 80 * time.Second, nil, 1},
+		{"Overflowed", 20 * time.Second, []int{4}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock.Sleep(tt.sleep)
+			if got := w.Advance(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TimerWheel.Advance() = %v, want %v", got, tt.want)
+			}
+			if got := w.Len(); got != tt.wantLen {
+				t.Errorf("TimerWheel.Len() = %v, want %v", got, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestTimerWheel_PastDue(t *testing.T) {
+	clock := newFakeClock()
+	w := NewTimerWheel[int](time.Second, 4, 2, clock.Now)
+	clock.Sleep(5 * time.Second)
+	w.Advance()
+
+	w.Add(3, clock.Now().Add(time.Second))
+	w.Add(2, clock.Now().Add(-time.Second))
+	w.Add(0, clock.Now().Add(-3*time.Second))
+	w.Add(4, clock.Now().Add(2*time.Second))
+	w.Add(1, clock.Now().Add(-2*time.Second))
+
+	clock.Sleep(2 * time.Second)
+	if got, want := w.Advance(), []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TimerWheel.Advance() = %v, want %v", got, want)
+	}
+}
+
+func TestTimerWheel_Random(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   int
+		levels int
+	}{
+		{"SingleLevel", 8, 1},
+		{"TwoLevels", 4, 2},
+		{"ThreeLevels", 4, 3},
+		{"ManyLevels", 2, 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			clock := newFakeClock()
+			w := NewTimerWheel[int](time.Millisecond, tt.size, tt.levels, clock.Now)
+			deadlines := make(map[int]time.Time) // deadlines of pending timers
+
+			for i := range 2000 {
+				switch r.Intn(3) {
+				case 0:
+					clock.Sleep(time.Duration(r.Intn(50)) * time.Millisecond)
+					got := w.Advance()
+
+					// Deadlines are multiples of tick, so values must be returned in order of deadlines.
+					for j := 1; j < len(got); j++ {
+						if deadlines[got[j-1]].After(deadlines[got[j]]) {
+							t.Fatalf("TimerWheel.Advance() = %v, %v expires before %v", got, got[j], got[j-1])
+						}
+					}
+
+					var want []int
+					for value, deadline := range deadlines {
+						if !deadline.After(clock.Now()) {
+							want = append(want, value)
+							delete(deadlines, value)
+						}
+					}
+
+					slices.Sort(got)
+					slices.Sort(want)
+					if !reflect.DeepEqual(got, want) {
+						t.Fatalf("TimerWheel.Advance() = %v at %v, want %v", got, clock.Now(), want)
+					}
+				default:
+					// Some timers are added after their deadlines.
+					deadline := clock.Now().Add(time.Duration(r.Intn(550)-50) * time.Millisecond)
+					w.Add(i, deadline)
+					deadlines[i] = deadline
+				}
+
+				if got := w.Len(); got != len(deadlines) {
+					t.Fatalf("TimerWheel.Len() = %v, want %v", got, len(deadlines))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package set
+
+import "math/bits"
+
+// wordSize is number of bits in word of bitset.
+const wordSize = 64
+
+// Bitset implements set of non-negative integers based on slice of 64-bit words.
+// Value i is contained in set if bit i%64 of word i/64 is set.
+// Memory usage is proportional to the greatest element.
+type Bitset struct {
+	words []uint64
+}
+
+// NewBitset returns new empty bitset with capacity for values less than n.
+// Negative n is treated as 0.
+func NewBitset(n int) *Bitset {
+	return &Bitset{make([]uint64, 0, (max(n, 0)+wordSize-1)/wordSize)}
+}
+
+// Len returns number of elements contained in set, O(n) where n is number of words.
+func (s *Bitset) Len() int {
+	count := 0
+	for _, word := range s.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// Add inserts value into set, amortized O(1).
+// It panics if value is negative.
+func (s *Bitset) Add(value int) {
+	if value < 0 {
+		panic("set: negative value added to bitset")
+	}
+
+	i := value / wordSize
+	if i >= len(s.words) {
+		s.words = append(s.words, make([]uint64, i-len(s.words)+1)...)
+	}
+	s.words[i] |= 1 << (value % wordSize)
+}
+
+// Remove removes value from set, O(1).
+func (s *Bitset) Remove(value int) {
+	if i := value / wordSize; value >= 0 && i < len(s.words) {
+		s.words[i] &^= 1 << (value % wordSize)
+	}
+}
+
+// Contains returns true if value is contained in set, O(1).
+func (s *Bitset) Contains(value int) bool {
+	i := value / wordSize
+	return value >= 0 && i < len(s.words) && s.words[i]&(1<<(value%wordSize)) != 0
+}
+
+// Range calls fn for each element of set in ascending order until fn returns false,
+// O(n+k) where n is number of words and k is number of elements.
+func (s *Bitset) Range(fn func(value int) bool) {
+	for i, word := range s.words {
+		for word != 0 {
+			if !fn(i*wordSize + bits.TrailingZeros64(word)) {
+				return
+			}
+			// Clear the lowest set bit.
+			word &= word - 1
+		}
+	}
+}
+
+// ToSlice returns new slice with elements of set in ascending order.
+func (s *Bitset) ToSlice() []int {
+	values := make([]int, 0, s.Len())
+	s.Range(func(value int) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Union returns new set with all elements from sets,
+// complexity is O(n+m) where n is number of words of s and m is number of words of t.
+func (s *Bitset) Union(t *Bitset) *Bitset {
+	long, short := s.words, t.words
+	if len(long) < len(short) {
+		long, short = short, long
+	}
+
+	words := make([]uint64, len(long))
+	copy(words, long)
+	for i, word := range short {
+		words[i] |= word
+	}
+
+	return &Bitset{words}
+}
+
+// Intersection returns new set with elements common to sets,
+// complexity is O(n) where n is number of words of smaller set.
+func (s *Bitset) Intersection(t *Bitset) *Bitset {
+	words := make([]uint64, min(len(s.words), len(t.words)))
+	for i := range words {
+		words[i] = s.words[i] & t.words[i]
+	}
+
+	return &Bitset{words}
+}
+
+// Difference returns new set with elements from s that are not in t,
+// complexity is O(n) where n is number of words of s.
+func (s *Bitset) Difference(t *Bitset) *Bitset {
+	words := make([]uint64, len(s.words))
+	copy(words, s.words)
+	for i := range min(len(words), len(t.words)) {
+		words[i] &^= t.words[i]
+	}
+
+	return &Bitset{words}
+}
+
+// SymmetricDifference returns new set with elements in either s or t but not both,
+// complexity is O(n+m) where n is number of words of s and m is number of words of t.
+func (s *Bitset) SymmetricDifference(t *Bitset) *Bitset {
+	long, short := s.words, t.words
+	if len(long) < len(short) {
+		long, short = short, long
+	}
+
+	words := make([]uint64, len(long))
+	copy(words, long)
+	for i, word := range short {
+		words[i] ^= word
+	}
+
+	return &Bitset{words}
+}
+
+// Equal returns true if sets contain the same elements,
+// complexity is O(n+m) where n is number of words of s and m is number of words of t.
+func (s *Bitset) Equal(t *Bitset) bool {
+	return s.Subset(t) && t.Subset(s)
+}
+
+// Subset returns true if t contains every element of s,
+// complexity is O(n) where n is number of words of s.
+func (s *Bitset) Subset(t *Bitset) bool {
+	for i, word := range s.words {
+		var other uint64
+		if i < len(t.words) {
+			other = t.words[i]
+		}
+		if word&^other != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Superset returns true if s contains every element of t,
+// complexity is O(n) where n is number of words of t.
+func (s *Bitset) Superset(t *Bitset) bool { return t.Subset(s) }
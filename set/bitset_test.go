@@ -0,0 +1,301 @@
+package set
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// bitset returns new bitset with specified elements.
+func bitset(values ...int) *Bitset {
+	s := NewBitset(0)
+	for _, value := range values {
+		s.Add(value)
+	}
+	return s
+}
+
+// hashSet returns new hash set with specified elements.
+func hashSet(values ...int) HashSet[int] {
+	s := make(HashSet[int], len(values))
+	for _, value := range values {
+		s.Add(value)
+	}
+	return s
+}
+
+// sortedKeys returns elements of hash set in ascending order.
+func sortedKeys(s HashSet[int]) []int {
+	values := make([]int, 0, len(s))
+	for value := range s {
+		values = append(values, value)
+	}
+	slices.Sort(values)
+	return values
+}
+
+func TestBitset_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s, want := NewBitset(100), make(HashSet[int])
+
+	for range 5000 {
+		value := r.Intn(1000)
+		if r.Intn(3) > 0 {
+			s.Add(value)
+			want.Add(value)
+		} else {
+			s.Remove(value)
+			want.Remove(value)
+		}
+
+		if got := s.Len(); got != want.Len() {
+			t.Fatalf("Bitset.Len() = %v, want %v", got, want.Len())
+		}
+	}
+
+	for value := -1; value <= 1100; value++ {
+		if got := s.Contains(value); got != want.Contains(value) {
+			t.Fatalf("Bitset.Contains(%v) = %v, want %v", value, got, want.Contains(value))
+		}
+	}
+	if got := s.ToSlice(); !slices.Equal(got, sortedKeys(want)) {
+		t.Fatalf("Bitset.ToSlice() = %v, want %v", got, sortedKeys(want))
+	}
+}
+
+func TestNewBitset(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want int
+	}{
+		{"NegativeCapacity", -1000, 0},
+		{"ZeroCapacity", 0, 0},
+		{"PartialWord", 65, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewBitset(tt.n)
+			if got := cap(s.words); got != tt.want {
+				t.Errorf("cap(NewBitset().words) = %v, want %v", got, tt.want)
+			}
+			if got := s.Len(); got != 0 {
+				t.Errorf("NewBitset().Len() = %v, want %v", got, 0)
+			}
+		})
+	}
+}
+
+func TestBitset_Add(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Bitset.Add() does not panic on negative value")
+		}
+	}()
+	bitset().Add(-1)
+}
+
+func TestBitset_Remove(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     *Bitset
+		value int
+		want  []int
+	}{
+		{"EmptySet", bitset(), 1, []int{}},
+		{"Negative", bitset(1), -1, []int{1}},
+		{"OutOfRange", bitset(1), 1000, []int{1}},
+		{"SimpleSet", bitset(1, 64, 65), 64, []int{1, 65}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.s.Remove(tt.value)
+			if got := tt.s.ToSlice(); !slices.Equal(got, tt.want) {
+				t.Errorf("Bitset.ToSlice() = %v after Remove(), want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBitset_Range(t *testing.T) {
+	s := bitset(0, 63, 64, 127, 128, 1000)
+	tests := []struct {
+		name  string
+		limit int
+		want  []int
+	}{
+		{"All", 10, []int{0, 63, 64, 127, 128, 1000}},
+		{"Stopped", 3, []int{0, 63, 64}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			s.Range(func(value int) bool {
+				got = append(got, value)
+				return len(got) < tt.limit
+			})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Bitset.Range() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBitset_Operations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name string
+		op   func(s, t *Bitset) *Bitset
+		want func(s, t HashSet[int]) HashSet[int]
+	}{
+		{"Union", (*Bitset).Union, HashSet[int].Union},
+		{"Intersection", (*Bitset).Intersection, HashSet[int].Intersection},
+		{"Difference", (*Bitset).Difference, HashSet[int].Difference},
+		{"SymmetricDifference", (*Bitset).SymmetricDifference, HashSet[int].SymmetricDifference},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, n := range [][2]int{{0, 0}, {10, 300}, {300, 10}, {200, 200}} {
+				a, b := NewBitset(0), NewBitset(0)
+				ha, hb := make(HashSet[int]), make(HashSet[int])
+				for range n[0] {
+					value := r.Intn(2 * n[0])
+					a.Add(value)
+					ha.Add(value)
+				}
+				for range n[1] {
+					value := r.Intn(2 * n[1])
+					b.Add(value)
+					hb.Add(value)
+				}
+
+				got, want := tt.op(a, b).ToSlice(), sortedKeys(tt.want(ha, hb))
+				if !slices.Equal(got, want) {
+					t.Errorf("Bitset.%v() = %v, want %v", tt.name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBitset_Compare(t *testing.T) {
+	// Removed elements leave trailing zero words that must not affect comparison.
+	trailing := bitset(1, 1000)
+	trailing.Remove(1000)
+
+	tests := []struct {
+		name  string
+		s     *Bitset
+		t     *Bitset
+		want  bool
+		want1 bool
+		want2 bool
+	}{
+		{"EmptySets", bitset(), bitset(), true, true, true},
+		{"EqualSets", bitset(1, 100), bitset(100, 1), true, true, true},
+		{"TrailingWords", trailing, bitset(1), true, true, true},
+		{"Subset", bitset(1), bitset(1, 100), false, true, false},
+		{"Superset", bitset(1, 100), bitset(100), false, false, true},
+		{"DifferentSets", bitset(1, 3), bitset(1, 2), false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Equal(tt.t); got != tt.want {
+				t.Errorf("Bitset.Equal() = %v, want %v", got, tt.want)
+			}
+			if got := tt.s.Subset(tt.t); got != tt.want1 {
+				t.Errorf("Bitset.Subset() = %v, want %v", got, tt.want1)
+			}
+			if got := tt.s.Superset(tt.t); got != tt.want2 {
+				t.Errorf("Bitset.Superset() = %v, want %v", got, tt.want2)
+			}
+		})
+	}
+}
+
+// benchmarkSize is number of values added to sets in benchmarks.
+const benchmarkSize = 10000
+
+// benchmarkValues returns n random values less than 2n.
+func benchmarkValues(n int) []int {
+	r := rand.New(rand.NewSource(1))
+	values := make([]int, n)
+	for i := range values {
+		values[i] = r.Intn(2 * n)
+	}
+	return values
+}
+
+func BenchmarkBitset_Add(b *testing.B) {
+	values := benchmarkValues(benchmarkSize)
+	b.ResetTimer()
+	for range b.N {
+		s := NewBitset(0)
+		for _, value := range values {
+			s.Add(value)
+		}
+	}
+}
+
+func BenchmarkHashSet_Add(b *testing.B) {
+	values := benchmarkValues(benchmarkSize)
+	b.ResetTimer()
+	for range b.N {
+		s := make(HashSet[int])
+		for _, value := range values {
+			s.Add(value)
+		}
+	}
+}
+
+func BenchmarkBitset_Contains(b *testing.B) {
+	s := bitset(benchmarkValues(benchmarkSize)...)
+	b.ResetTimer()
+	for range b.N {
+		for value := range 2 * benchmarkSize {
+			s.Contains(value)
+		}
+	}
+}
+
+func BenchmarkHashSet_Contains(b *testing.B) {
+	s := hashSet(benchmarkValues(benchmarkSize)...)
+	b.ResetTimer()
+	for range b.N {
+		for value := range 2 * benchmarkSize {
+			s.Contains(value)
+		}
+	}
+}
+
+func BenchmarkBitset_Union(b *testing.B) {
+	s, t := bitset(benchmarkValues(benchmarkSize)...), bitset(benchmarkValues(benchmarkSize/2)...)
+	b.ResetTimer()
+	for range b.N {
+		s.Union(t)
+	}
+}
+
+func BenchmarkHashSet_Union(b *testing.B) {
+	s, t := hashSet(benchmarkValues(benchmarkSize)...), hashSet(benchmarkValues(benchmarkSize/2)...)
+	b.ResetTimer()
+	for range b.N {
+		s.Union(t)
+	}
+}
+
+func BenchmarkBitset_Intersection(b *testing.B) {
+	s, t := bitset(benchmarkValues(benchmarkSize)...), bitset(benchmarkValues(benchmarkSize/2)...)
+	b.ResetTimer()
+	for range b.N {
+		s.Intersection(t)
+	}
+}
+
+func BenchmarkHashSet_Intersection(b *testing.B) {
+	s, t := hashSet(benchmarkValues(benchmarkSize)...), hashSet(benchmarkValues(benchmarkSize/2)...)
+	b.ResetTimer()
+	for range b.N {
+		s.Intersection(t)
+	}
+}
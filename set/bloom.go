@@ -0,0 +1,222 @@
+package set
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+var (
+	// ErrIncompatible is returned when probabilistic sets with different parameters are combined.
+	ErrIncompatible = errors.New("set: incompatible parameters")
+	// ErrInvalidData is returned when serialized probabilistic set is malformed.
+	ErrInvalidData = errors.New("set: invalid data")
+)
+
+// defaultFalsePositiveRate is used when specified false positive rate is out of range.
+const defaultFalsePositiveRate = 0.01
+
+// maxBloomHashes is maximum number of hash functions of Bloom filter.
+// It is enough for false positive rate about 2^-64.
+const maxBloomHashes = 64
+
+// bloomSize returns optimal number of bits m and number of hash functions k
+// for filter with n values and false positive rate p.
+// k is at most maxBloomHashes.
+func bloomSize(n int, p float64) (m, k int) {
+	if p <= 0 || p >= 1 {
+		p = defaultFalsePositiveRate
+	}
+	n = max(n, 1)
+
+	m = int(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k = int(math.Round(float64(m) / float64(n) * math.Ln2))
+	return max(m, 1), min(max(k, 1), maxBloomHashes)
+}
+
+// bloomIndexes calls fn for k indexes in range [0, m) derived from hash
+// using double hashing.
+func bloomIndexes(hash uint64, k, m int, fn func(i int)) {
+	h1 := mix64(hash)
+	// Odd h2 avoids repeating indexes when m is a power of two.
+	h2 := mix64(h1) | 1
+	for i := range k {
+		fn(int((h1 + uint64(i)*h2) % uint64(m)))
+	}
+}
+
+// BloomFilter implements probabilistic set based on bit array and k hash functions.
+// Contains never returns false for added value, but it may return true for value
+// that was not added. Values can't be removed from filter.
+type BloomFilter[T any] struct {
+	bits Bitset
+	m    int // number of bits
+	k    int // number of hash functions
+	hash Hasher[T]
+}
+
+// NewBloomFilter returns new empty Bloom filter sized for n values with false positive rate p.
+// If p is not in range (0, 1), it uses 0.01. hash is used to hash values.
+func NewBloomFilter[T any](n int, p float64, hash Hasher[T]) *BloomFilter[T] {
+	m, k := bloomSize(n, p)
+	return &BloomFilter[T]{Bitset{make([]uint64, (m+wordSize-1)/wordSize)}, m, k, hash}
+}
+
+// Add inserts value into filter, O(k).
+func (f *BloomFilter[T]) Add(value T) {
+	bloomIndexes(f.hash(value), f.k, f.m, f.bits.Add)
+}
+
+// Contains returns true if value is possibly contained in filter, O(k).
+// It returns false if value is definitely not contained in filter.
+func (f *BloomFilter[T]) Contains(value T) bool {
+	contains := true
+	bloomIndexes(f.hash(value), f.k, f.m, func(i int) {
+		contains = contains && f.bits.Contains(i)
+	})
+	return contains
+}
+
+// Union returns new filter that contains values from both filters, O(m).
+// Filters should use the same hash function.
+// It returns ErrIncompatible if filters have different number of bits or hash functions.
+func (f *BloomFilter[T]) Union(g *BloomFilter[T]) (*BloomFilter[T], error) {
+	if f.m != g.m || f.k != g.k {
+		return nil, ErrIncompatible
+	}
+	return &BloomFilter[T]{*f.bits.Union(&g.bits), f.m, f.k, f.hash}, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes number of bits, number of hash functions and bit array.
+func (f *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 16+8*len(f.bits.words))
+	data = binary.LittleEndian.AppendUint64(data, uint64(f.m))
+	data = binary.LittleEndian.AppendUint64(data, uint64(f.k))
+	for _, word := range f.bits.words {
+		data = binary.LittleEndian.AppendUint64(data, word)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It replaces parameters and content of filter, but keeps its hash function.
+// It returns ErrInvalidData if data is malformed.
+func (f *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	m, k, data, ok := decodeBloomHeader(data)
+	if !ok || len(data) != 8*((m+wordSize-1)/wordSize) {
+		return ErrInvalidData
+	}
+
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	f.bits, f.m, f.k = Bitset{words}, m, k
+	return nil
+}
+
+// decodeBloomHeader decodes number of bits and number of hash functions
+// and returns remaining data. It returns false if header is malformed
+// or number of hash functions is greater than maxBloomHashes.
+func decodeBloomHeader(data []byte) (m, k int, rest []byte, ok bool) {
+	if len(data) < 16 {
+		return 0, 0, nil, false
+	}
+
+	m64, k64 := binary.LittleEndian.Uint64(data), binary.LittleEndian.Uint64(data[8:])
+	if m64 < 1 || m64 > math.MaxInt32 || k64 < 1 || k64 > maxBloomHashes {
+		return 0, 0, nil, false
+	}
+	return int(m64), int(k64), data[16:], true
+}
+
+// CountingBloomFilter implements Bloom filter with 8-bit counters instead of bits,
+// so values can be removed from filter. Counters saturate at 255 and are never
+// decremented after that to avoid false negatives.
+type CountingBloomFilter[T any] struct {
+	counters []uint8
+	k        int // number of hash functions
+	hash     Hasher[T]
+}
+
+// NewCountingBloomFilter returns new empty counting Bloom filter sized for n values
+// with false positive rate p. If p is not in range (0, 1), it uses 0.01.
+// hash is used to hash values.
+func NewCountingBloomFilter[T any](n int, p float64, hash Hasher[T]) *CountingBloomFilter[T] {
+	m, k := bloomSize(n, p)
+	return &CountingBloomFilter[T]{make([]uint8, m), k, hash}
+}
+
+// Add inserts value into filter, O(k).
+func (f *CountingBloomFilter[T]) Add(value T) {
+	bloomIndexes(f.hash(value), f.k, len(f.counters), func(i int) {
+		if f.counters[i] < math.MaxUint8 {
+			f.counters[i]++
+		}
+	})
+}
+
+// Remove removes value from filter, O(k).
+// Only values that were added should be removed, otherwise filter may
+// return false negatives. It returns false if value is definitely not
+// contained in filter and filter is not changed.
+func (f *CountingBloomFilter[T]) Remove(value T) bool {
+	if !f.Contains(value) {
+		return false
+	}
+
+	bloomIndexes(f.hash(value), f.k, len(f.counters), func(i int) {
+		if f.counters[i] < math.MaxUint8 {
+			f.counters[i]--
+		}
+	})
+	return true
+}
+
+// Contains returns true if value is possibly contained in filter, O(k).
+// It returns false if value is definitely not contained in filter.
+func (f *CountingBloomFilter[T]) Contains(value T) bool {
+	contains := true
+	bloomIndexes(f.hash(value), f.k, len(f.counters), func(i int) {
+		contains = contains && f.counters[i] > 0
+	})
+	return contains
+}
+
+// Union returns new filter that contains values from both filters, O(m).
+// Counters are summed. Filters should use the same hash function.
+// It returns ErrIncompatible if filters have different number of counters or hash functions.
+func (f *CountingBloomFilter[T]) Union(g *CountingBloomFilter[T]) (*CountingBloomFilter[T], error) {
+	if len(f.counters) != len(g.counters) || f.k != g.k {
+		return nil, ErrIncompatible
+	}
+
+	counters := make([]uint8, len(f.counters))
+	for i := range counters {
+		counters[i] = uint8(min(int(f.counters[i])+int(g.counters[i]), math.MaxUint8))
+	}
+	return &CountingBloomFilter[T]{counters, f.k, f.hash}, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes number of counters, number of hash functions and counters.
+func (f *CountingBloomFilter[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 16+len(f.counters))
+	data = binary.LittleEndian.AppendUint64(data, uint64(len(f.counters)))
+	data = binary.LittleEndian.AppendUint64(data, uint64(f.k))
+	return append(data, f.counters...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It replaces parameters and content of filter, but keeps its hash function.
+// It returns ErrInvalidData if data is malformed.
+func (f *CountingBloomFilter[T]) UnmarshalBinary(data []byte) error {
+	m, k, data, ok := decodeBloomHeader(data)
+	if !ok || len(data) != m {
+		return ErrInvalidData
+	}
+
+	f.counters, f.k = append([]uint8(nil), data...), k
+	return nil
+}
@@ -0,0 +1,222 @@
+package set
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// intHash returns value as hash. Filters mix hash bits, so identity hash is enough for tests.
+func intHash(value int) uint64 { return uint64(value) }
+
+// probabilisticSet is a filter that can be checked for false negatives and false positives.
+type probabilisticSet interface {
+	Add(value int)
+	Contains(value int) bool
+}
+
+// testFalsePositiveRate adds n values to filter, checks that all of them are contained
+// and that observed false positive rate on other values does not exceed limit.
+func testFalsePositiveRate(t *testing.T, f probabilisticSet, n int, limit float64) {
+	t.Helper()
+	for i := range n {
+		f.Add(i)
+	}
+	for i := range n {
+		if !f.Contains(i) {
+			t.Fatalf("filter does not contain added value %v", i)
+		}
+	}
+
+	const trials = 100000
+	positives := 0
+	for i := n; i < n+trials; i++ {
+		if f.Contains(i) {
+			positives++
+		}
+	}
+	if rate := float64(positives) / trials; rate > limit {
+		t.Errorf("observed false positive rate = %v, want at most %v", rate, limit)
+	}
+}
+
+func TestBloomFilter_FalsePositiveRate(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		p    float64
+	}{
+		{"OnePercent", 10000, 0.01},
+		{"TenthPercent", 10000, 0.001},
+		{"TenPercent", 1000, 0.1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testFalsePositiveRate(t, NewBloomFilter(tt.n, tt.p, intHash), tt.n, 1.5*tt.p)
+		})
+		t.Run("Counting"+tt.name, func(t *testing.T) {
+			testFalsePositiveRate(t, NewCountingBloomFilter(tt.n, tt.p, intHash), tt.n, 1.5*tt.p)
+		})
+	}
+}
+
+func TestBloomFilter_Strings(t *testing.T) {
+	f := NewBloomFilter(100, 0.01, StringHash)
+	for i := range 100 {
+		f.Add("event-" + strconv.Itoa(i))
+	}
+	for i := range 100 {
+		if value := "event-" + strconv.Itoa(i); !f.Contains(value) {
+			t.Errorf("BloomFilter.Contains(%q) = %v, want %v", value, false, true)
+		}
+	}
+}
+
+func TestBloomFilter_Union(t *testing.T) {
+	f, g := NewBloomFilter(1000, 0.01, intHash), NewBloomFilter(1000, 0.01, intHash)
+	for i := range 500 {
+		f.Add(i)
+		g.Add(500 + i)
+	}
+
+	u, err := f.Union(g)
+	if err != nil {
+		t.Fatalf("BloomFilter.Union() error = %v", err)
+	}
+	for i := range 1000 {
+		if !u.Contains(i) {
+			t.Fatalf("BloomFilter.Contains(%v) = %v after Union(), want %v", i, false, true)
+		}
+	}
+
+	if _, err := f.Union(NewBloomFilter(10, 0.01, intHash)); !errors.Is(err, ErrIncompatible) {
+		t.Errorf("BloomFilter.Union() error = %v, want %v", err, ErrIncompatible)
+	}
+}
+
+// withHashes returns copy of serialized filter with number of hash functions k.
+func withHashes(data []byte, k uint64) []byte {
+	data = slices.Clone(data)
+	binary.LittleEndian.PutUint64(data[8:], k)
+	return data
+}
+
+func TestBloomFilter_Binary(t *testing.T) {
+	f := NewBloomFilter(1000, 0.01, intHash)
+	for i := range 1000 {
+		f.Add(i)
+	}
+
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("BloomFilter.MarshalBinary() error = %v", err)
+	}
+	g := NewBloomFilter(1, 0.5, intHash)
+	if err := g.UnmarshalBinary(data); err != nil {
+		t.Fatalf("BloomFilter.UnmarshalBinary() error = %v", err)
+	}
+	for i := range 2000 {
+		if got, want := g.Contains(i), f.Contains(i); got != want {
+			t.Fatalf("BloomFilter.Contains(%v) = %v after UnmarshalBinary(), want %v", i, got, want)
+		}
+	}
+
+	for _, data := range [][]byte{nil, data[:16], data[:len(data)-1], append(data, 0), withHashes(data, 65)} {
+		if err := g.UnmarshalBinary(data); !errors.Is(err, ErrInvalidData) {
+			t.Errorf("BloomFilter.UnmarshalBinary() error = %v, want %v", err, ErrInvalidData)
+		}
+	}
+	// Filter with very low false positive rate uses at most maxBloomHashes hash functions.
+	data, _ = NewBloomFilter(10, 1e-30, intHash).MarshalBinary()
+	if err := g.UnmarshalBinary(data); err != nil {
+		t.Errorf("BloomFilter.UnmarshalBinary() error = %v for very low false positive rate", err)
+	}
+}
+
+func TestCountingBloomFilter_Remove(t *testing.T) {
+	f := NewCountingBloomFilter(1000, 0.01, intHash)
+	for i := range 1000 {
+		f.Add(i)
+	}
+	for i := range 500 {
+		if !f.Remove(i) {
+			t.Fatalf("CountingBloomFilter.Remove(%v) = %v, want %v", i, false, true)
+		}
+	}
+
+	for i := 500; i < 1000; i++ {
+		if !f.Contains(i) {
+			t.Fatalf("CountingBloomFilter.Contains(%v) = %v after Remove(), want %v", i, false, true)
+		}
+	}
+	// Most removed values are not contained anymore.
+	positives := 0
+	for i := range 500 {
+		if f.Contains(i) {
+			positives++
+		}
+	}
+	if positives > 25 {
+		t.Errorf("CountingBloomFilter contains %v of 500 removed values, want at most %v", positives, 25)
+	}
+
+	if g := NewCountingBloomFilter(10, 0.01, intHash); g.Remove(1) {
+		t.Errorf("CountingBloomFilter.Remove() = %v on empty filter, want %v", true, false)
+	}
+}
+
+func TestCountingBloomFilter_Union(t *testing.T) {
+	f, g := NewCountingBloomFilter(1000, 0.01, intHash), NewCountingBloomFilter(1000, 0.01, intHash)
+	for i := range 500 {
+		f.Add(i)
+		g.Add(i)
+	}
+
+	u, err := f.Union(g)
+	if err != nil {
+		t.Fatalf("CountingBloomFilter.Union() error = %v", err)
+	}
+	// Values added to both filters remain after single removal.
+	for i := range 500 {
+		u.Remove(i)
+	}
+	for i := range 500 {
+		if !u.Contains(i) {
+			t.Fatalf("CountingBloomFilter.Contains(%v) = %v after Union() and Remove(), want %v", i, false, true)
+		}
+	}
+
+	if _, err := f.Union(NewCountingBloomFilter(10, 0.01, intHash)); !errors.Is(err, ErrIncompatible) {
+		t.Errorf("CountingBloomFilter.Union() error = %v, want %v", err, ErrIncompatible)
+	}
+}
+
+func TestCountingBloomFilter_Binary(t *testing.T) {
+	f := NewCountingBloomFilter(1000, 0.01, intHash)
+	for i := range 1000 {
+		f.Add(i)
+	}
+
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("CountingBloomFilter.MarshalBinary() error = %v", err)
+	}
+	g := NewCountingBloomFilter(1, 0.5, intHash)
+	if err := g.UnmarshalBinary(data); err != nil {
+		t.Fatalf("CountingBloomFilter.UnmarshalBinary() error = %v", err)
+	}
+	for i := range 1000 {
+		if !g.Remove(i) {
+			t.Fatalf("CountingBloomFilter.Remove(%v) = %v after UnmarshalBinary(), want %v", i, false, true)
+		}
+	}
+
+	for _, data := range [][]byte{nil, data[:8], data[:len(data)-1], withHashes(data, 65), withHashes(data, math.MaxInt32)} {
+		if err := g.UnmarshalBinary(data); !errors.Is(err, ErrInvalidData) {
+			t.Errorf("CountingBloomFilter.UnmarshalBinary() error = %v, want %v", err, ErrInvalidData)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package set
+
+import "math/bits"
+
+const (
+	// cuckooBucketSize is number of fingerprints in bucket of cuckoo filter.
+	cuckooBucketSize = 4
+	// cuckooMaxKicks is maximum number of relocations during insertion.
+	cuckooMaxKicks = 500
+	// defaultFingerprintBits is used when specified fingerprint size is out of range.
+	defaultFingerprintBits = 8
+)
+
+// cuckooBucket contains fingerprints of bucket, 0 means empty entry.
+type cuckooBucket [cuckooBucketSize]uint16
+
+// CuckooFilter implements probabilistic set based on cuckoo hashing of fingerprints.
+// Contains never returns false for added value, but it may return true for value
+// that was not added. Unlike Bloom filter, it supports removal of added values.
+type CuckooFilter[T any] struct {
+	buckets []cuckooBucket
+	mask    uint64 // number of buckets minus one
+	bits    int    // fingerprint size in bits
+	len     int
+	victim  uint16 // fingerprint that did not fit into filter, 0 if there is none
+	index   uint64 // bucket index of victim
+	rand    uint64 // state of xorshift generator used to choose kicked entries
+	hash    Hasher[T]
+}
+
+// NewCuckooFilter returns new empty cuckoo filter with capacity for n values
+// and fingerprints of specified size in bits. If size is not in range [1, 16], it uses 8.
+// hash is used to hash values.
+func NewCuckooFilter[T any](n int, size int, hash Hasher[T]) *CuckooFilter[T] {
+	if size < 1 || size > 16 {
+		size = defaultFingerprintBits
+	}
+
+	// Filter with load factor about 95% is almost always fillable.
+	count := (max(n, 1)*100/95 + cuckooBucketSize - 1) / cuckooBucketSize
+	count = 1 << bits.Len(uint(count-1))
+	return &CuckooFilter[T]{
+		buckets: make([]cuckooBucket, count),
+		mask:    uint64(count - 1),
+		bits:    size,
+		rand:    0x9e3779b97f4a7c15,
+		hash:    hash,
+	}
+}
+
+// Len returns number of values contained in filter, O(1).
+func (f *CuckooFilter[T]) Len() int { return f.len }
+
+// Cap returns maximum number of values contained in filter, O(1).
+func (f *CuckooFilter[T]) Cap() int { return len(f.buckets) * cuckooBucketSize }
+
+// FalsePositiveRate returns upper bound of false positive rate of full filter,
+// that is 2b/2^f where b is bucket size and f is fingerprint size, O(1).
+func (f *CuckooFilter[T]) FalsePositiveRate() float64 {
+	return 2 * cuckooBucketSize / float64(uint64(1)<<f.bits)
+}
+
+// Add inserts value into filter, amortized O(1).
+// It returns false if filter is full and value is not inserted.
+// The same value can be added several times and should be removed the same number of times.
+func (f *CuckooFilter[T]) Add(value T) bool {
+	if f.victim != 0 {
+		return false
+	}
+
+	fp, i1 := f.locate(value)
+	i2 := f.alternate(i1, fp)
+	if f.insert(i1, fp) || f.insert(i2, fp) {
+		f.len++
+		return true
+	}
+
+	// Relocate random entries to their alternate buckets.
+	i := i1
+	if f.next()&1 == 1 {
+		i = i2
+	}
+	for range cuckooMaxKicks {
+		j := f.next() % cuckooBucketSize
+		fp, f.buckets[i][j] = f.buckets[i][j], fp
+		i = f.alternate(i, fp)
+		if f.insert(i, fp) {
+			f.len++
+			return true
+		}
+	}
+
+	// Keep the last kicked fingerprint to avoid false negatives.
+	f.victim, f.index = fp, i
+	f.len++
+	return true
+}
+
+// Contains returns true if value is possibly contained in filter, O(1).
+// It returns false if value is definitely not contained in filter.
+func (f *CuckooFilter[T]) Contains(value T) bool {
+	fp, i1 := f.locate(value)
+	i2 := f.alternate(i1, fp)
+	if f.victim == fp && (f.index == i1 || f.index == i2) {
+		return true
+	}
+	return f.find(i1, fp) >= 0 || f.find(i2, fp) >= 0
+}
+
+// Remove removes value from filter, O(1).
+// Only values that were added should be removed, otherwise filter may
+// return false negatives. It returns false if value is definitely not
+// contained in filter and filter is not changed.
+func (f *CuckooFilter[T]) Remove(value T) bool {
+	fp, i1 := f.locate(value)
+	i2 := f.alternate(i1, fp)
+	if f.victim == fp && (f.index == i1 || f.index == i2) {
+		f.victim = 0
+		f.len--
+		return true
+	}
+
+	for _, i := range [2]uint64{i1, i2} {
+		if j := f.find(i, fp); j >= 0 {
+			f.buckets[i][j] = 0
+			f.len--
+			f.reinsertVictim()
+			return true
+		}
+	}
+	return false
+}
+
+// reinsertVictim tries to insert victim into filter after removal.
+func (f *CuckooFilter[T]) reinsertVictim() {
+	if f.victim == 0 {
+		return
+	}
+
+	fp, i := f.victim, f.index
+	if f.insert(i, fp) || f.insert(f.alternate(i, fp), fp) {
+		f.victim = 0
+	}
+}
+
+// locate returns fingerprint of value and index of its primary bucket.
+func (f *CuckooFilter[T]) locate(value T) (uint16, uint64) {
+	h := mix64(f.hash(value))
+	fp := uint16(h >> 32 & (1<<f.bits - 1))
+	if fp == 0 {
+		fp = 1
+	}
+	return fp, h & f.mask
+}
+
+// alternate returns index of alternate bucket for fingerprint stored in bucket i.
+// It is an involution, so alternate of alternate bucket is bucket i.
+func (f *CuckooFilter[T]) alternate(i uint64, fp uint16) uint64 {
+	return (i ^ mix64(uint64(fp))) & f.mask
+}
+
+// insert inserts fingerprint into empty entry of bucket i.
+// It returns false if bucket is full.
+func (f *CuckooFilter[T]) insert(i uint64, fp uint16) bool {
+	if j := f.find(i, 0); j >= 0 {
+		f.buckets[i][j] = fp
+		return true
+	}
+	return false
+}
+
+// find returns position of fingerprint in bucket i or -1 if it is not found.
+func (f *CuckooFilter[T]) find(i uint64, fp uint16) int {
+	for j, entry := range f.buckets[i] {
+		if entry == fp {
+			return j
+		}
+	}
+	return -1
+}
+
+// next returns next pseudo-random number of xorshift generator.
+func (f *CuckooFilter[T]) next() uint64 {
+	f.rand ^= f.rand << 13
+	f.rand ^= f.rand >> 7
+	f.rand ^= f.rand << 17
+	return f.rand
+}
@@ -0,0 +1,112 @@
+package set
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCuckooFilter_FalsePositiveRate(t *testing.T) {
+	for _, size := range []int{4, 8, 12, 16} {
+		t.Run(fmt.Sprintf("%vBits", size), func(t *testing.T) {
+			const n = 10000
+			f := NewCuckooFilter(n, size, intHash)
+			testFalsePositiveRate(t, cuckooSet{f}, n, f.FalsePositiveRate())
+			if got := f.Len(); got != n {
+				t.Errorf("CuckooFilter.Len() = %v, want %v", got, n)
+			}
+		})
+	}
+}
+
+// cuckooSet adapts cuckoo filter to probabilisticSet.
+type cuckooSet struct{ *CuckooFilter[int] }
+
+func (s cuckooSet) Add(value int) { s.CuckooFilter.Add(value) }
+
+func TestCuckooFilter_Full(t *testing.T) {
+	f := NewCuckooFilter(1000, 8, intHash)
+
+	n := 0
+	for f.Add(n) {
+		n++
+	}
+	if n < f.Cap()*9/10 || n > f.Cap()+1 {
+		t.Errorf("CuckooFilter accepts %v values, want about %v", n, f.Cap())
+	}
+	if got := f.Len(); got != n {
+		t.Errorf("CuckooFilter.Len() = %v, want %v", got, n)
+	}
+	for i := range n {
+		if !f.Contains(i) {
+			t.Fatalf("CuckooFilter.Contains(%v) = %v, want %v", i, false, true)
+		}
+	}
+
+	// Filter accepts values again after removal.
+	if !f.Remove(0) {
+		t.Fatalf("CuckooFilter.Remove(%v) = %v, want %v", 0, false, true)
+	}
+	for i := range n / 2 {
+		f.Remove(i)
+	}
+	if !f.Add(0) {
+		t.Errorf("CuckooFilter.Add() = %v after Remove(), want %v", false, true)
+	}
+	for i := n / 2; i < n; i++ {
+		if !f.Contains(i) {
+			t.Fatalf("CuckooFilter.Contains(%v) = %v after Remove(), want %v", i, false, true)
+		}
+	}
+}
+
+func TestCuckooFilter_Remove(t *testing.T) {
+	f := NewCuckooFilter(1000, 16, intHash)
+	for i := range 1000 {
+		f.Add(i)
+	}
+	// Duplicate must be removed twice.
+	f.Add(1)
+
+	for i := range 500 {
+		if !f.Remove(i) {
+			t.Fatalf("CuckooFilter.Remove(%v) = %v, want %v", i, false, true)
+		}
+	}
+	if got := f.Len(); got != 501 {
+		t.Errorf("CuckooFilter.Len() = %v, want %v", got, 501)
+	}
+
+	tests := []struct {
+		name  string
+		value int
+		want  bool
+	}{
+		{"Removed", 0, false},
+		{"Duplicate", 1, true},
+		{"Remained", 500, true},
+		{"NotAdded", 5000, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := f.Contains(tt.value); got != tt.want {
+				t.Errorf("CuckooFilter.Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if f.Remove(5000) {
+		t.Errorf("CuckooFilter.Remove() = %v for value not added, want %v", true, false)
+	}
+}
+
+func TestCuckooFilter_Strings(t *testing.T) {
+	f := NewCuckooFilter(100, 0, StringHash)
+	for i := range 100 {
+		f.Add(fmt.Sprint("event-", i))
+	}
+	for i := range 100 {
+		if value := fmt.Sprint("event-", i); !f.Contains(value) {
+			t.Errorf("CuckooFilter.Contains(%q) = %v, want %v", value, false, true)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package set
+
+// Hasher is a function that returns 64-bit hash of value.
+// Probabilistic sets expect hashes to be uniformly distributed
+// and stable across processes if sets are serialized.
+type Hasher[T any] func(value T) uint64
+
+const (
+	fnvOffset = 14695981039346656037
+	fnvPrime  = 1099511628211
+)
+
+// StringHash returns 64-bit FNV-1a hash of s.
+func StringHash(s string) uint64 {
+	h := uint64(fnvOffset)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= fnvPrime
+	}
+	return h
+}
+
+// BytesHash returns 64-bit FNV-1a hash of b.
+func BytesHash(b []byte) uint64 {
+	h := uint64(fnvOffset)
+	for _, c := range b {
+		h ^= uint64(c)
+		h *= fnvPrime
+	}
+	return h
+}
+
+// mix64 returns hash with bits of h mixed by finalizer of SplitMix64.
+// It improves distribution of weak hash functions.
+func mix64(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
@@ -0,0 +1,25 @@
+package set
+
+import "testing"
+
+func TestStringHash(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want uint64
+	}{
+		{"Empty", "", 0xcbf29ce484222325},
+		{"Letter", "a", 0xaf63dc4c8601ec8c},
+		{"Word", "foobar", 0x85944171f73967e8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StringHash(tt.s); got != tt.want {
+				t.Errorf("StringHash() = %#x, want %#x", got, tt.want)
+			}
+			if got := BytesHash([]byte(tt.s)); got != tt.want {
+				t.Errorf("BytesHash() = %#x, want %#x", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package set
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"slices"
+)
+
+const (
+	// minPrecision and maxPrecision bound precision of HyperLogLog sketch.
+	minPrecision = 4
+	maxPrecision = 18
+	// defaultPrecision is used when specified precision is out of range.
+	defaultPrecision = 14
+)
+
+// Formats of serialized HyperLogLog sketch.
+const (
+	sparseFormat byte = iota
+	denseFormat
+)
+
+// HyperLogLog implements probabilistic cardinality estimator.
+// It estimates number of distinct added values with standard error about 1.04/sqrt(m)
+// using m = 2^precision registers of 1 byte each.
+//
+// While sketch contains few values, it uses sparse representation that stores only
+// non-zero registers, so small sketches use memory proportional to number of values.
+type HyperLogLog[T any] struct {
+	precision int
+	sparse    []uint32 // sorted pairs of register index and value encoded as index<<8 | value
+	dense     []uint8  // registers, nil while sketch is sparse
+	hash      Hasher[T]
+}
+
+// NewHyperLogLog returns new empty HyperLogLog sketch with specified precision.
+// If precision is not in range [4, 18], it uses 14. hash is used to hash values.
+func NewHyperLogLog[T any](precision int, hash Hasher[T]) *HyperLogLog[T] {
+	if precision < minPrecision || precision > maxPrecision {
+		precision = defaultPrecision
+	}
+	return &HyperLogLog[T]{precision: precision, hash: hash}
+}
+
+// Add inserts value into sketch, O(1) if sketch is dense.
+func (h *HyperLogLog[T]) Add(value T) {
+	x := mix64(h.hash(value))
+	index := uint32(x >> (64 - h.precision))
+	// Guard bit limits rank when remaining bits are zero.
+	rank := uint8(bits.LeadingZeros64(x<<h.precision|1<<(h.precision-1)) + 1)
+	h.set(index, rank)
+}
+
+// Count returns estimated number of distinct values added to sketch, O(m).
+func (h *HyperLogLog[T]) Count() uint64 {
+	m := float64(uint64(1) << h.precision)
+	sum, zeros := 0.0, m
+
+	h.rangeRegisters(func(_ uint32, value uint8) {
+		sum += math.Ldexp(1, -int(value))
+		zeros--
+	})
+	// Zero registers contribute 2^0 each.
+	sum += zeros
+
+	estimate := alpha(m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// Linear counting is more accurate for small cardinalities.
+		estimate = m * math.Log(m/zeros)
+	}
+	return uint64(math.Round(estimate))
+}
+
+// Merge adds values of other sketch to h, O(m).
+// Sketches should use the same hash function.
+// It returns ErrIncompatible if sketches have different precision.
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h.precision != other.precision {
+		return ErrIncompatible
+	}
+
+	// Sparse receiver is promoted first, because inserting pairs one by one is not linear.
+	if h.dense == nil && (other.dense != nil || len(h.sparse)+len(other.sparse) > h.sparseLimit()) {
+		h.toDense()
+	}
+	if h.dense != nil {
+		other.rangeRegisters(h.set)
+		return nil
+	}
+
+	h.sparse = mergeSparse(h.sparse, other.sparse)
+	return nil
+}
+
+// mergeSparse returns new sorted slice of sparse pairs from a and b.
+// If index is contained in both slices, pair with maximum value is taken.
+func mergeSparse(a, b []uint32) []uint32 {
+	merged := make([]uint32, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		switch {
+		case a[0]>>8 < b[0]>>8:
+			merged, a = append(merged, a[0]), a[1:]
+		case a[0]>>8 > b[0]>>8:
+			merged, b = append(merged, b[0]), b[1:]
+		default:
+			merged, a, b = append(merged, max(a[0], b[0])), a[1:], b[1:]
+		}
+	}
+	merged = append(merged, a...)
+	return append(merged, b...)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes format, precision and either sparse pairs or dense registers.
+func (h *HyperLogLog[T]) MarshalBinary() ([]byte, error) {
+	if h.dense != nil {
+		data := make([]byte, 0, 2+len(h.dense))
+		data = append(data, denseFormat, byte(h.precision))
+		return append(data, h.dense...), nil
+	}
+
+	data := make([]byte, 0, 2+4*len(h.sparse))
+	data = append(data, sparseFormat, byte(h.precision))
+	for _, pair := range h.sparse {
+		data = binary.LittleEndian.AppendUint32(data, pair)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It replaces precision and content of sketch, but keeps its hash function.
+// It returns ErrInvalidData if data is malformed.
+func (h *HyperLogLog[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 || data[1] < minPrecision || data[1] > maxPrecision {
+		return ErrInvalidData
+	}
+
+	precision := int(data[1])
+	m, maxRank := 1<<precision, uint8(64-precision+1)
+	format, data := data[0], data[2:]
+
+	switch {
+	case format == denseFormat && len(data) == m:
+		for _, value := range data {
+			if value > maxRank {
+				return ErrInvalidData
+			}
+		}
+		h.precision, h.sparse, h.dense = precision, nil, slices.Clone(data)
+	case format == sparseFormat && len(data)%4 == 0:
+		sparse := make([]uint32, len(data)/4)
+		for i := range sparse {
+			pair := binary.LittleEndian.Uint32(data[4*i:])
+			// Pairs must be sorted by index, with index and value in range.
+			if pair>>8 >= uint32(m) || pair&0xff == 0 || uint8(pair) > maxRank ||
+				i > 0 && pair>>8 <= sparse[i-1]>>8 {
+				return ErrInvalidData
+			}
+			sparse[i] = pair
+		}
+		h.precision, h.sparse, h.dense = precision, sparse, nil
+		if len(sparse) > h.sparseLimit() {
+			h.toDense()
+		}
+	default:
+		return ErrInvalidData
+	}
+
+	return nil
+}
+
+// set updates register index with maximum of its value and specified value.
+func (h *HyperLogLog[T]) set(index uint32, value uint8) {
+	if h.dense != nil {
+		h.dense[index] = max(h.dense[index], value)
+		return
+	}
+
+	i, found := slices.BinarySearchFunc(h.sparse, index, func(pair, index uint32) int {
+		return int(pair>>8) - int(index)
+	})
+	if found {
+		h.sparse[i] = index<<8 | uint32(max(uint8(h.sparse[i]), value))
+		return
+	}
+
+	h.sparse = slices.Insert(h.sparse, i, index<<8|uint32(value))
+	if len(h.sparse) > h.sparseLimit() {
+		h.toDense()
+	}
+}
+
+// sparseLimit returns maximum number of sparse pairs,
+// that take as much memory as dense registers.
+func (h *HyperLogLog[T]) sparseLimit() int { return (1 << h.precision) / 4 }
+
+// toDense converts sparse representation to dense registers.
+func (h *HyperLogLog[T]) toDense() {
+	dense := make([]uint8, 1<<h.precision)
+	for _, pair := range h.sparse {
+		dense[pair>>8] = uint8(pair)
+	}
+	h.sparse, h.dense = nil, dense
+}
+
+// rangeRegisters calls fn for each non-zero register.
+func (h *HyperLogLog[T]) rangeRegisters(fn func(index uint32, value uint8)) {
+	if h.dense == nil {
+		for _, pair := range h.sparse {
+			fn(pair>>8, uint8(pair))
+		}
+		return
+	}
+
+	for i, value := range h.dense {
+		if value != 0 {
+			fn(uint32(i), value)
+		}
+	}
+}
+
+// alpha returns bias correction constant for m registers.
+func alpha(m float64) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/m)
+}
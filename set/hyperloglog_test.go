@@ -0,0 +1,164 @@
+package set
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+)
+
+// hyperLogLog returns new sketch with specified precision and values in range [from, to).
+func hyperLogLog(precision, from, to int) *HyperLogLog[int] {
+	h := NewHyperLogLog(precision, intHash)
+	for i := from; i < to; i++ {
+		h.Add(i)
+	}
+	return h
+}
+
+// checkCount checks that estimated count of sketch is within
+// three standard errors of want.
+func checkCount(t *testing.T, h *HyperLogLog[int], want int) {
+	t.Helper()
+	limit := 3 * 1.04 / math.Sqrt(float64(uint64(1)<<h.precision))
+	got := h.Count()
+	if err := math.Abs(float64(got)-float64(want)) / float64(max(want, 1)); err > limit {
+		t.Errorf("HyperLogLog.Count() = %v, want %v with relative error at most %v", got, want, limit)
+	}
+}
+
+func TestHyperLogLog_Count(t *testing.T) {
+	for _, precision := range []int{4, 10, 14, 18} {
+		for _, n := range []int{0, 10, 1000, 100000} {
+			t.Run(fmt.Sprintf("Precision%v/%vValues", precision, n), func(t *testing.T) {
+				h := hyperLogLog(precision, 0, n)
+				// Duplicates do not change estimate.
+				for i := range n {
+					h.Add(i)
+				}
+				checkCount(t, h, n)
+			})
+		}
+	}
+}
+
+func TestHyperLogLog_Sparse(t *testing.T) {
+	h := hyperLogLog(14, 0, 100)
+	if h.dense != nil {
+		t.Fatalf("HyperLogLog is dense with %v values, want sparse", 100)
+	}
+	if got := h.Count(); got != 100 {
+		t.Errorf("HyperLogLog.Count() = %v, want %v", got, 100)
+	}
+
+	for i := 100; h.dense == nil; i++ {
+		h.Add(i)
+		if len(h.sparse) > h.sparseLimit() {
+			t.Fatalf("HyperLogLog has %v sparse pairs, want at most %v", len(h.sparse), h.sparseLimit())
+		}
+	}
+	// Estimate is not changed by conversion.
+	sparse := hyperLogLog(14, 0, 3000)
+	dense := hyperLogLog(14, 0, 3000)
+	dense.toDense()
+	if got, want := dense.Count(), sparse.Count(); got != want {
+		t.Errorf("HyperLogLog.Count() = %v after conversion, want %v", got, want)
+	}
+}
+
+func TestHyperLogLog_Merge(t *testing.T) {
+	tests := []struct {
+		name  string
+		h     *HyperLogLog[int]
+		g     *HyperLogLog[int]
+		want  int
+		dense bool
+	}{
+		{"SparseSketches", hyperLogLog(14, 0, 500), hyperLogLog(14, 250, 750), 750, false},
+		{"SparseSketchesOverLimit", hyperLogLog(14, 0, 3000), hyperLogLog(14, 3000, 6000), 6000, true},
+		{"SparseIntoDense", hyperLogLog(14, 0, 50000), hyperLogLog(14, 49900, 50100), 50100, true},
+		{"DenseIntoSparse", hyperLogLog(14, 0, 100), hyperLogLog(14, 100, 50000), 50000, true},
+		{"DenseSketches", hyperLogLog(14, 0, 50000), hyperLogLog(14, 25000, 75000), 75000, true},
+		{"SameSketch", hyperLogLog(10, 0, 100), nil, 100, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.g == nil {
+				tt.g = tt.h
+			}
+			if err := tt.h.Merge(tt.g); err != nil {
+				t.Fatalf("HyperLogLog.Merge() error = %v", err)
+			}
+			checkCount(t, tt.h, tt.want)
+			if dense := tt.h.dense != nil; dense != tt.dense {
+				t.Errorf("HyperLogLog is dense = %v after Merge(), want %v", dense, tt.dense)
+			}
+			if !slices.IsSortedFunc(tt.h.sparse, func(a, b uint32) int { return cmp.Compare(a>>8, b>>8) }) {
+				t.Errorf("HyperLogLog has unsorted sparse pairs after Merge()")
+			}
+
+			// Merged sketch equals sketch of all values.
+			want := hyperLogLog(tt.h.precision, 0, tt.want)
+			if got, want := tt.h.Count(), want.Count(); got != want {
+				t.Errorf("HyperLogLog.Count() = %v after Merge(), want %v", got, want)
+			}
+		})
+	}
+
+	if err := hyperLogLog(10, 0, 1).Merge(hyperLogLog(12, 0, 1)); !errors.Is(err, ErrIncompatible) {
+		t.Errorf("HyperLogLog.Merge() error = %v, want %v", err, ErrIncompatible)
+	}
+}
+
+func TestHyperLogLog_Binary(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HyperLogLog[int]
+	}{
+		{"EmptySketch", hyperLogLog(4, 0, 0)},
+		{"SparseSketch", hyperLogLog(14, 0, 1000)},
+		{"DenseSketch", hyperLogLog(12, 0, 100000)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.h.MarshalBinary()
+			if err != nil {
+				t.Fatalf("HyperLogLog.MarshalBinary() error = %v", err)
+			}
+			g := NewHyperLogLog(18, intHash)
+			if err := g.UnmarshalBinary(data); err != nil {
+				t.Fatalf("HyperLogLog.UnmarshalBinary() error = %v", err)
+			}
+			if got, want := g.Count(), tt.h.Count(); got != want {
+				t.Errorf("HyperLogLog.Count() = %v after UnmarshalBinary(), want %v", got, want)
+			}
+			if (g.dense == nil) != (tt.h.dense == nil) {
+				t.Errorf("HyperLogLog representation is changed by UnmarshalBinary()")
+			}
+		})
+	}
+
+	invalid := []struct {
+		name string
+		data []byte
+	}{
+		{"Empty", nil},
+		{"Precision", []byte{denseFormat, 3}},
+		{"Format", []byte{2, 4}},
+		{"DenseLength", append([]byte{denseFormat, 4}, make([]byte, 15)...)},
+		{"DenseRegister", append([]byte{denseFormat, 4}, append(make([]byte, 15), 62)...)},
+		{"SparseLength", []byte{sparseFormat, 4, 1, 0, 0}},
+		{"SparseIndex", []byte{sparseFormat, 4, 1, 16, 0, 0}},
+		{"SparseZero", []byte{sparseFormat, 4, 0, 1, 0, 0}},
+		{"SparseOrder", []byte{sparseFormat, 4, 1, 2, 0, 0, 1, 1, 0, 0}},
+	}
+	for _, tt := range invalid {
+		t.Run("Invalid"+tt.name, func(t *testing.T) {
+			if err := NewHyperLogLog(4, intHash).UnmarshalBinary(tt.data); !errors.Is(err, ErrInvalidData) {
+				t.Errorf("HyperLogLog.UnmarshalBinary() error = %v, want %v", err, ErrInvalidData)
+			}
+		})
+	}
+}
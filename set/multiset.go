@@ -0,0 +1,125 @@
+package set
+
+// Multiset implements multiset (bag) based on hash table of element counts.
+// Only elements with positive counts are stored.
+type Multiset[T comparable] map[T]int
+
+// MultisetFromHashSet returns new multiset that contains each element of s once,
+// O(n) where n is length of s.
+func MultisetFromHashSet[T comparable](s HashSet[T]) Multiset[T] {
+	m := make(Multiset[T], len(s))
+	for value := range s {
+		m[value] = 1
+	}
+	return m
+}
+
+// Len returns number of distinct elements contained in multiset, O(1).
+func (m Multiset[T]) Len() int { return len(m) }
+
+// Size returns total number of elements contained in multiset
+// counting multiplicity, O(n) where n is number of distinct elements.
+func (m Multiset[T]) Size() int {
+	size := 0
+	for _, count := range m {
+		size += count
+	}
+	return size
+}
+
+// Add inserts n copies of value into multiset, O(1).
+// If n is not positive, multiset is not changed.
+func (m Multiset[T]) Add(value T, n int) {
+	if n > 0 {
+		m[value] += n
+	}
+}
+
+// Remove removes n copies of value from multiset, O(1).
+// If multiset contains less than n copies, all of them are removed.
+// If n is not positive, multiset is not changed.
+func (m Multiset[T]) Remove(value T, n int) {
+	if n <= 0 {
+		return
+	}
+
+	if count := m[value]; count > n {
+		m[value] = count - n
+	} else {
+		delete(m, value)
+	}
+}
+
+// Count returns number of copies of value contained in multiset, O(1).
+func (m Multiset[T]) Count(value T) int { return m[value] }
+
+// Union returns new multiset where count of each element is maximum of its counts in m and t,
+// complexity is O(n+k) where n is length of m and k is length of t.
+func (m Multiset[T]) Union(t Multiset[T]) Multiset[T] {
+	union := make(Multiset[T], max(len(m), len(t)))
+	for value, count := range m {
+		union[value] = count
+	}
+
+	for value, count := range t {
+		union[value] = max(union[value], count)
+	}
+
+	return union
+}
+
+// Sum returns new multiset where count of each element is sum of its counts in m and t,
+// complexity is O(n+k) where n is length of m and k is length of t.
+func (m Multiset[T]) Sum(t Multiset[T]) Multiset[T] {
+	sum := make(Multiset[T], max(len(m), len(t)))
+	for value, count := range m {
+		sum[value] = count
+	}
+
+	for value, count := range t {
+		sum[value] += count
+	}
+
+	return sum
+}
+
+// Intersection returns new multiset where count of each element is minimum of its counts in m and t,
+// complexity is O(n) where n is length of smaller multiset.
+func (m Multiset[T]) Intersection(t Multiset[T]) Multiset[T] {
+	if len(m) > len(t) {
+		m, t = t, m
+	}
+
+	intersection := make(Multiset[T], len(m))
+	for value, count := range m {
+		if other := t[value]; other > 0 {
+			intersection[value] = min(count, other)
+		}
+	}
+
+	return intersection
+}
+
+// Difference returns new multiset where count of each element is its count in m
+// minus its count in t, elements with non-positive counts are omitted,
+// complexity is O(n) where n is length of m.
+func (m Multiset[T]) Difference(t Multiset[T]) Multiset[T] {
+	diff := make(Multiset[T])
+	for value, count := range m {
+		if count > t[value] {
+			diff[value] = count - t[value]
+		}
+	}
+
+	return diff
+}
+
+// ToHashSet returns new set with distinct elements of multiset,
+// complexity is O(n) where n is length of m.
+func (m Multiset[T]) ToHashSet() HashSet[T] {
+	s := make(HashSet[T], len(m))
+	for value := range m {
+		s.Add(value)
+	}
+	return s
+}
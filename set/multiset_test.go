@@ -0,0 +1,142 @@
+package set
+
+import (
+	"reflect"
+	"testing"
+)
+
+func simpleMultisetA() Multiset[int] { return Multiset[int]{1: 2, 2: 1} }
+
+func simpleMultisetB() Multiset[int] { return Multiset[int]{1: 1, 2: 3, 3: 1} }
+
+func TestMultiset_Len(t *testing.T) {
+	tests := []struct {
+		name  string
+		m     Multiset[int]
+		want  int
+		want1 int
+	}{
+		{"EmptyMultiset", Multiset[int]{}, 0, 0},
+		{"SimpleMultiset", simpleMultisetB(), 3, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Len(); got != tt.want {
+				t.Errorf("Multiset.Len() = %v, want %v", got, tt.want)
+			}
+			if got := tt.m.Size(); got != tt.want1 {
+				t.Errorf("Multiset.Size() = %v, want %v", got, tt.want1)
+			}
+		})
+	}
+}
+
+func TestMultiset_Add(t *testing.T) {
+	type args struct {
+		value int
+		n     int
+	}
+	tests := []struct {
+		name string
+		m    Multiset[int]
+		args args
+		want Multiset[int]
+	}{
+		{"EmptyMultiset", Multiset[int]{}, args{1, 2}, Multiset[int]{1: 2}},
+		{"ExistingElement", simpleMultisetA(), args{1, 3}, Multiset[int]{1: 5, 2: 1}},
+		{"ZeroCopies", simpleMultisetA(), args{3, 0}, simpleMultisetA()},
+		{"NegativeCopies", simpleMultisetA(), args{1, -1}, simpleMultisetA()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.m.Add(tt.args.value, tt.args.n)
+			if !reflect.DeepEqual(tt.m, tt.want) {
+				t.Errorf("multiset = %v after Multiset.Add(), want %v", tt.m, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiset_Remove(t *testing.T) {
+	type args struct {
+		value int
+		n     int
+	}
+	tests := []struct {
+		name string
+		m    Multiset[int]
+		args args
+		want Multiset[int]
+	}{
+		{"EmptyMultiset", Multiset[int]{}, args{1, 1}, Multiset[int]{}},
+		{"SomeCopies", simpleMultisetA(), args{1, 1}, Multiset[int]{1: 1, 2: 1}},
+		{"AllCopies", simpleMultisetA(), args{1, 2}, Multiset[int]{2: 1}},
+		{"MoreCopies", simpleMultisetA(), args{1, 5}, Multiset[int]{2: 1}},
+		{"ZeroCopies", simpleMultisetA(), args{1, 0}, simpleMultisetA()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.m.Remove(tt.args.value, tt.args.n)
+			if !reflect.DeepEqual(tt.m, tt.want) {
+				t.Errorf("multiset = %v after Multiset.Remove(), want %v", tt.m, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiset_Count(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int
+		want  int
+	}{
+		{"Contained", 1, 2},
+		{"NotContained", 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := simpleMultisetA().Count(tt.value); got != tt.want {
+				t.Errorf("Multiset.Count() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiset_Operations(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func(m, t Multiset[int]) Multiset[int]
+		m    Multiset[int]
+		t    Multiset[int]
+		want Multiset[int]
+	}{
+		{"Union", Multiset[int].Union, simpleMultisetA(), simpleMultisetB(), Multiset[int]{1: 2, 2: 3, 3: 1}},
+		{"UnionEmpty", Multiset[int].Union, Multiset[int]{}, simpleMultisetA(), simpleMultisetA()},
+		{"Sum", Multiset[int].Sum, simpleMultisetA(), simpleMultisetB(), Multiset[int]{1: 3, 2: 4, 3: 1}},
+		{"SumEmpty", Multiset[int].Sum, simpleMultisetA(), Multiset[int]{}, simpleMultisetA()},
+		{"Intersection", Multiset[int].Intersection, simpleMultisetA(), simpleMultisetB(), Multiset[int]{1: 1, 2: 1}},
+		{"IntersectionEmpty", Multiset[int].Intersection, simpleMultisetA(), Multiset[int]{}, Multiset[int]{}},
+		{"Difference", Multiset[int].Difference, simpleMultisetA(), simpleMultisetB(), Multiset[int]{1: 1}},
+		{"DifferenceReversed", Multiset[int].Difference, simpleMultisetB(), simpleMultisetA(), Multiset[int]{2: 2, 3: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.op(tt.m, tt.t); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Multiset.%v() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiset_HashSet(t *testing.T) {
+	m := simpleMultisetB()
+	want := HashSet[int]{1: struct{}{}, 2: struct{}{}, 3: struct{}{}}
+	if got := m.ToHashSet(); !got.Equal(want) {
+		t.Errorf("Multiset.ToHashSet() = %v, want %v", got, want)
+	}
+
+	wantMultiset := Multiset[int]{1: 1, 2: 1, 3: 1}
+	if got := MultisetFromHashSet(want); !reflect.DeepEqual(got, wantMultiset) {
+		t.Errorf("MultisetFromHashSet() = %v, want %v", got, wantMultiset)
+	}
+}
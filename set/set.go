@@ -1,5 +1,6 @@
 // Package set implements set data structures.
-// It provides hash set implementation with core operations.
+// It provides hash set, sorted set, bitset and multiset implementations with core operations
+// and probabilistic structures such as Bloom filters and HyperLogLog.
 package set
 
 // HashSet implements set based on hash table of empty structs.
@@ -0,0 +1,494 @@
+package set
+
+// sortedNode implements a node of AVL tree augmented with subtree size.
+type sortedNode[T any] struct {
+	value  T
+	left   *sortedNode[T]
+	right  *sortedNode[T]
+	height int
+	size   int // number of nodes in subtree
+}
+
+// getHeight returns height of subtree or 0 if node is nil.
+func (n *sortedNode[T]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// getSize returns number of nodes in subtree or 0 if node is nil.
+func (n *sortedNode[T]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// update recalculates height and size of node from its children.
+func (n *sortedNode[T]) update() {
+	n.height = max(n.left.getHeight(), n.right.getHeight()) + 1
+	n.size = n.left.getSize() + n.right.getSize() + 1
+}
+
+// SortedSet implements ordered set based on AVL tree.
+// Elements are ordered by comparison function.
+type SortedSet[T any] struct {
+	root *sortedNode[T]
+	cmp  func(a, b T) int
+}
+
+// NewSortedSet returns new empty sorted set ordered by cmp.
+// cmp should return 0 if a is equal b, a negative number if a precedes b,
+// or a positive number if a follows b.
+func NewSortedSet[T any](cmp func(a, b T) int) *SortedSet[T] {
+	return &SortedSet[T]{cmp: cmp}
+}
+
+// Len returns number of elements contained in set, O(1).
+func (s SortedSet[T]) Len() int { return s.root.getSize() }
+
+// Add inserts value into set, O(log(n)).
+// If equal value is already contained in set, set is not changed.
+func (s *SortedSet[T]) Add(value T) { s.root = s.add(s.root, value) }
+
+// Remove removes value from set, O(log(n)).
+func (s *SortedSet[T]) Remove(value T) { s.root = s.remove(s.root, value) }
+
+// Contains returns true if value is contained in set, O(log(n)).
+func (s SortedSet[T]) Contains(value T) bool {
+	for node := s.root; node != nil; {
+		c := s.cmp(value, node.value)
+		switch {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			node = node.right
+		default:
+			return true
+		}
+	}
+
+	return false
+}
+
+// Min returns the least element of set, O(log(n)).
+// If set is empty, it returns default value of type T and false as second value.
+func (s SortedSet[T]) Min() (T, bool) {
+	node := s.root
+	if node == nil {
+		var value T
+		return value, false
+	}
+
+	for node.left != nil {
+		node = node.left
+	}
+	return node.value, true
+}
+
+// Max returns the greatest element of set, O(log(n)).
+// If set is empty, it returns default value of type T and false as second value.
+func (s SortedSet[T]) Max() (T, bool) {
+	node := s.root
+	if node == nil {
+		var value T
+		return value, false
+	}
+
+	for node.right != nil {
+		node = node.right
+	}
+	return node.value, true
+}
+
+// Floor returns the greatest element of set that is less than or equal to value, O(log(n)).
+// If there is no such element, it returns default value of type T and false as second value.
+func (s SortedSet[T]) Floor(value T) (T, bool) {
+	var found *sortedNode[T]
+	for node := s.root; node != nil; {
+		c := s.cmp(value, node.value)
+		switch {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			found, node = node, node.right
+		default:
+			return node.value, true
+		}
+	}
+
+	if found == nil {
+		var value T
+		return value, false
+	}
+	return found.value, true
+}
+
+// Ceiling returns the least element of set that is greater than or equal to value, O(log(n)).
+// If there is no such element, it returns default value of type T and false as second value.
+func (s SortedSet[T]) Ceiling(value T) (T, bool) {
+	var found *sortedNode[T]
+	for node := s.root; node != nil; {
+		c := s.cmp(value, node.value)
+		switch {
+		case c < 0:
+			found, node = node, node.left
+		case c > 0:
+			node = node.right
+		default:
+			return node.value, true
+		}
+	}
+
+	if found == nil {
+		var value T
+		return value, false
+	}
+	return found.value, true
+}
+
+// Rank returns number of elements of set that are less than value, O(log(n)).
+func (s SortedSet[T]) Rank(value T) int {
+	rank := 0
+	for node := s.root; node != nil; {
+		c := s.cmp(value, node.value)
+		switch {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			rank += node.left.getSize() + 1
+			node = node.right
+		default:
+			return rank + node.left.getSize()
+		}
+	}
+
+	return rank
+}
+
+// Select returns element of set with index i in ascending order, O(log(n)).
+// If i is out of range, it returns default value of type T and false as second value.
+func (s SortedSet[T]) Select(i int) (T, bool) {
+	if i < 0 || i >= s.Len() {
+		var value T
+		return value, false
+	}
+
+	node := s.root
+	for {
+		left := node.left.getSize()
+		switch {
+		case i < left:
+			node = node.left
+		case i > left:
+			i -= left + 1
+			node = node.right
+		default:
+			return node.value, true
+		}
+	}
+}
+
+// Range calls fn for each element of set in ascending order until fn returns false, O(n).
+func (s SortedSet[T]) Range(fn func(value T) bool) { s.rangeNode(s.root, nil, nil, fn) }
+
+// RangeBetween calls fn for each element of set that is greater than or equal to from
+// and less than to in ascending order until fn returns false, O(log(n)+k).
+func (s SortedSet[T]) RangeBetween(from, to T, fn func(value T) bool) {
+	s.rangeNode(s.root, &from, &to, fn)
+}
+
+// ToSlice returns new slice with elements of set in ascending order, O(n).
+func (s SortedSet[T]) ToSlice() []T {
+	values := make([]T, 0, s.Len())
+	s.Range(func(value T) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Union returns new set with all elements from sets,
+// complexity is O(n+m) where n is length of s and m is length of t.
+// Sets should be ordered by the same comparison function.
+func (s SortedSet[T]) Union(t *SortedSet[T]) *SortedSet[T] {
+	return s.merge(t, true, true, true)
+}
+
+// Intersection returns new set with elements common to sets,
+// complexity is O(n+m) where n is length of s and m is length of t.
+// Sets should be ordered by the same comparison function.
+func (s SortedSet[T]) Intersection(t *SortedSet[T]) *SortedSet[T] {
+	return s.merge(t, false, true, false)
+}
+
+// Difference returns new set with elements from s that are not in t,
+// complexity is O(n+m) where n is length of s and m is length of t.
+// Sets should be ordered by the same comparison function.
+func (s SortedSet[T]) Difference(t *SortedSet[T]) *SortedSet[T] {
+	return s.merge(t, true, false, false)
+}
+
+// SymmetricDifference returns new set with elements in either s or t but not both,
+// complexity is O(n+m) where n is length of s and m is length of t.
+// Sets should be ordered by the same comparison function.
+func (s SortedSet[T]) SymmetricDifference(t *SortedSet[T]) *SortedSet[T] {
+	return s.merge(t, true, false, true)
+}
+
+// Equal returns true if s contains every element of t and their lengths are equal,
+// complexity is O(n+m) where n is length of s and m is length of t.
+func (s SortedSet[T]) Equal(t *SortedSet[T]) bool {
+	return s.Len() == t.Len() && s.Subset(t)
+}
+
+// Subset returns true if t contains every element of s,
+// complexity is O(n+m) where n is length of s and m is length of t.
+// Sets are walked in ascending order simultaneously without allocating memory.
+// Sets should be ordered by the same comparison function.
+func (s SortedSet[T]) Subset(t *SortedSet[T]) bool {
+	if s.Len() > t.Len() {
+		return false
+	}
+
+	var a, b sortedIterator[T]
+	a.pushLeft(s.root)
+	b.pushLeft(t.root)
+	for {
+		x, ok := a.next()
+		if !ok {
+			return true
+		}
+
+		// Skip elements of t that are less than x.
+		for {
+			y, ok := b.next()
+			if !ok {
+				return false
+			}
+			if c := s.cmp(y.value, x.value); c == 0 {
+				break
+			} else if c > 0 {
+				return false
+			}
+		}
+	}
+}
+
+// Superset returns true if s contains every element of t,
+// complexity is O(n+m) where n is length of s and m is length of t.
+func (s SortedSet[T]) Superset(t *SortedSet[T]) bool { return t.Subset(&s) }
+
+// merge returns new set with elements selected from sorted elements of s and t.
+// onlyS, both and onlyT specify whether elements contained only in s, in both sets
+// and only in t are selected.
+func (s SortedSet[T]) merge(t *SortedSet[T], onlyS, both, onlyT bool) *SortedSet[T] {
+	a, b := s.ToSlice(), t.ToSlice()
+	values := make([]T, 0, len(a)+len(b))
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		c := s.cmp(a[i], b[j])
+		switch {
+		case c < 0:
+			if onlyS {
+				values = append(values, a[i])
+			}
+			i++
+		case c > 0:
+			if onlyT {
+				values = append(values, b[j])
+			}
+			j++
+		default:
+			if both {
+				values = append(values, a[i])
+			}
+			i++
+			j++
+		}
+	}
+	if onlyS {
+		values = append(values, a[i:]...)
+	}
+	if onlyT {
+		values = append(values, b[j:]...)
+	}
+
+	return &SortedSet[T]{buildSorted(values), s.cmp}
+}
+
+// buildSorted returns root of balanced tree with sorted values, O(n).
+func buildSorted[T any](values []T) *sortedNode[T] {
+	if len(values) == 0 {
+		return nil
+	}
+
+	mid := len(values) / 2
+	node := &sortedNode[T]{
+		value: values[mid],
+		left:  buildSorted(values[:mid]),
+		right: buildSorted(values[mid+1:]),
+	}
+	node.update()
+	return node
+}
+
+// sortedIterator implements in-order traversal of AVL tree based on explicit stack.
+// Height of AVL tree with n nodes is less than 1.45*log2(n+2),
+// so fixed-size stack is enough for any tree and iterator does not allocate memory.
+type sortedIterator[T any] struct {
+	stack [92]*sortedNode[T]
+	len   int
+}
+
+// pushLeft pushes node and its left descendants onto stack.
+func (it *sortedIterator[T]) pushLeft(node *sortedNode[T]) {
+	for ; node != nil; node = node.left {
+		it.stack[it.len] = node
+		it.len++
+	}
+}
+
+// next returns the next node in ascending order.
+// If all nodes are traversed, it returns nil and false as second value.
+func (it *sortedIterator[T]) next() (*sortedNode[T], bool) {
+	if it.len == 0 {
+		return nil, false
+	}
+
+	it.len--
+	node := it.stack[it.len]
+	it.pushLeft(node.right)
+	return node, true
+}
+
+// rangeNode calls fn for elements of subtree between from and to in ascending order.
+// If from or to is nil, range is not bounded from that side.
+// It returns false if fn returns false.
+func (s SortedSet[T]) rangeNode(node *sortedNode[T], from, to *T, fn func(value T) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	afterFrom := from == nil || s.cmp(node.value, *from) >= 0
+	beforeTo := to == nil || s.cmp(node.value, *to) < 0
+
+	// Left subtree can contain elements in range only if node is not before from.
+	if afterFrom && !s.rangeNode(node.left, from, to, fn) {
+		return false
+	}
+	if afterFrom && beforeTo && !fn(node.value) {
+		return false
+	}
+	// Right subtree can contain elements in range only if node is before to.
+	if beforeTo {
+		return s.rangeNode(node.right, from, to, fn)
+	}
+	return true
+}
+
+// add inserts value into subtree and returns its new root.
+func (s *SortedSet[T]) add(node *sortedNode[T], value T) *sortedNode[T] {
+	if node == nil {
+		return &sortedNode[T]{value: value, height: 1, size: 1}
+	}
+
+	c := s.cmp(value, node.value)
+	switch {
+	case c < 0:
+		node.left = s.add(node.left, value)
+	case c > 0:
+		node.right = s.add(node.right, value)
+	default:
+		return node
+	}
+
+	return rebalance(node)
+}
+
+// remove removes value from subtree and returns its new root.
+func (s *SortedSet[T]) remove(node *sortedNode[T], value T) *sortedNode[T] {
+	if node == nil {
+		return nil
+	}
+
+	c := s.cmp(value, node.value)
+	switch {
+	case c < 0:
+		node.left = s.remove(node.left, value)
+	case c > 0:
+		node.right = s.remove(node.right, value)
+	default:
+		if node.left == nil {
+			return node.right
+		}
+		if node.right == nil {
+			return node.left
+		}
+
+		// Replace node with the least node of right subtree.
+		var least *sortedNode[T]
+		node.right, least = removeMin(node.right)
+		least.left, least.right = node.left, node.right
+		node = least
+	}
+
+	return rebalance(node)
+}
+
+// removeMin removes the least node from subtree.
+// It returns new root of subtree and the removed node.
+func removeMin[T any](node *sortedNode[T]) (*sortedNode[T], *sortedNode[T]) {
+	if node.left == nil {
+		right := node.right
+		node.right = nil
+		return right, node
+	}
+
+	var least *sortedNode[T]
+	node.left, least = removeMin(node.left)
+	return rebalance(node), least
+}
+
+// rebalance restores AVL balance of node with balanced subtrees and returns new root.
+func rebalance[T any](node *sortedNode[T]) *sortedNode[T] {
+	node.update()
+	balance := node.left.getHeight() - node.right.getHeight()
+
+	switch {
+	case balance > 1:
+		if node.left.left.getHeight() < node.left.right.getHeight() {
+			node.left = rotateLeft(node.left)
+		}
+		return rotateRight(node)
+	case balance < -1:
+		if node.right.right.getHeight() < node.right.left.getHeight() {
+			node.right = rotateRight(node.right)
+		}
+		return rotateLeft(node)
+	}
+
+	return node
+}
+
+// rotateLeft makes right child the root of subtree and returns it.
+func rotateLeft[T any](node *sortedNode[T]) *sortedNode[T] {
+	right := node.right
+	node.right = right.left
+	right.left = node
+	node.update()
+	right.update()
+	return right
+}
+
+// rotateRight makes left child the root of subtree and returns it.
+func rotateRight[T any](node *sortedNode[T]) *sortedNode[T] {
+	left := node.left
+	node.left = left.right
+	left.right = node
+	node.update()
+	left.update()
+	return left
+}
@@ -0,0 +1,259 @@
+package set
+
+import (
+	"cmp"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// sortedSet returns new sorted set with specified elements.
+func sortedSet(values ...int) *SortedSet[int] {
+	s := NewSortedSet(cmp.Compare[int])
+	for _, value := range values {
+		s.Add(value)
+	}
+	return s
+}
+
+// checkSortedNode checks AVL balance, heights and sizes of subtree.
+// It returns height of subtree.
+func checkSortedNode(t *testing.T, node *sortedNode[int]) int {
+	if node == nil {
+		return 0
+	}
+
+	left, right := checkSortedNode(t, node.left), checkSortedNode(t, node.right)
+	if left-right > 1 || right-left > 1 {
+		t.Fatalf("node %v is not balanced: left height %v, right height %v", node.value, left, right)
+	}
+	if node.height != max(left, right)+1 || node.size != node.left.getSize()+node.right.getSize()+1 {
+		t.Fatalf("node %v has height %v and size %v, want %v and %v",
+			node.value, node.height, node.size, max(left, right)+1, node.left.getSize()+node.right.getSize()+1)
+	}
+	return node.height
+}
+
+func TestSortedSet_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := sortedSet()
+	var want []int // sorted elements expected to be contained in set
+
+	for range 5000 {
+		value := r.Intn(500)
+		i, found := slices.BinarySearch(want, value)
+		if r.Intn(3) > 0 {
+			s.Add(value)
+			if !found {
+				want = slices.Insert(want, i, value)
+			}
+		} else {
+			s.Remove(value)
+			if found {
+				want = slices.Delete(want, i, i+1)
+			}
+		}
+
+		if got := s.Len(); got != len(want) {
+			t.Fatalf("SortedSet.Len() = %v, want %v", got, len(want))
+		}
+	}
+
+	checkSortedNode(t, s.root)
+	if got := s.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("SortedSet.ToSlice() = %v, want %v", got, want)
+	}
+	for value := -1; value <= 500; value++ {
+		i, found := slices.BinarySearch(want, value)
+		if got := s.Contains(value); got != found {
+			t.Fatalf("SortedSet.Contains(%v) = %v, want %v", value, got, found)
+		}
+		if got := s.Rank(value); got != i {
+			t.Fatalf("SortedSet.Rank(%v) = %v, want %v", value, got, i)
+		}
+	}
+	for i, value := range want {
+		if got, ok := s.Select(i); !ok || got != value {
+			t.Fatalf("SortedSet.Select(%v) = (%v, %v), want (%v, %v)", i, got, ok, value, true)
+		}
+	}
+}
+
+func TestSortedSet_MinMax(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     *SortedSet[int]
+		want  int
+		want1 int
+		want2 bool
+	}{
+		{"EmptySet", sortedSet(), 0, 0, false},
+		{"SimpleSet", sortedSet(3, 1, 2), 1, 3, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := tt.s.Min(); got != tt.want || ok != tt.want2 {
+				t.Errorf("SortedSet.Min() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.want2)
+			}
+			if got, ok := tt.s.Max(); got != tt.want1 || ok != tt.want2 {
+				t.Errorf("SortedSet.Max() = (%v, %v), want (%v, %v)", got, ok, tt.want1, tt.want2)
+			}
+		})
+	}
+}
+
+func TestSortedSet_FloorCeiling(t *testing.T) {
+	s := sortedSet(10, 20, 30)
+	tests := []struct {
+		name  string
+		value int
+		want  int
+		want1 bool
+		want2 int
+		want3 bool
+	}{
+		{"Less", 5, 0, false, 10, true},
+		{"Equal", 20, 20, true, 20, true},
+		{"Between", 25, 20, true, 30, true},
+		{"Greater", 35, 30, true, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := s.Floor(tt.value); got != tt.want || ok != tt.want1 {
+				t.Errorf("SortedSet.Floor() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.want1)
+			}
+			if got, ok := s.Ceiling(tt.value); got != tt.want2 || ok != tt.want3 {
+				t.Errorf("SortedSet.Ceiling() = (%v, %v), want (%v, %v)", got, ok, tt.want2, tt.want3)
+			}
+		})
+	}
+}
+
+func TestSortedSet_Select(t *testing.T) {
+	tests := []struct {
+		name  string
+		i     int
+		want  int
+		want1 bool
+	}{
+		{"First", 0, 10, true},
+		{"Last", 2, 30, true},
+		{"Negative", -1, 0, false},
+		{"OutOfRange", 3, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := sortedSet(30, 10, 20).Select(tt.i)
+			if got != tt.want {
+				t.Errorf("SortedSet.Select() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("SortedSet.Select() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
+func TestSortedSet_RangeBetween(t *testing.T) {
+	s := sortedSet(5, 1, 9, 3, 7, 2, 8)
+	tests := []struct {
+		name  string
+		from  int
+		to    int
+		limit int
+		want  []int
+	}{
+		{"All", 0, 10, 10, []int{1, 2, 3, 5, 7, 8, 9}},
+		{"HalfOpen", 2, 8, 10, []int{2, 3, 5, 7}},
+		{"Empty", 4, 5, 10, nil},
+		{"Stopped", 2, 8, 2, []int{2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			s.RangeBetween(tt.from, tt.to, func(value int) bool {
+				got = append(got, value)
+				return len(got) < tt.limit
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SortedSet.RangeBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortedSet_Operations(t *testing.T) {
+	a, b := sortedSet(1, 2, 3, 4), sortedSet(3, 4, 5)
+	tests := []struct {
+		name string
+		op   func(s, t *SortedSet[int]) *SortedSet[int]
+		want []int
+	}{
+		{"Union", (*SortedSet[int]).Union, []int{1, 2, 3, 4, 5}},
+		{"Intersection", (*SortedSet[int]).Intersection, []int{3, 4}},
+		{"Difference", (*SortedSet[int]).Difference, []int{1, 2}},
+		{"SymmetricDifference", (*SortedSet[int]).SymmetricDifference, []int{1, 2, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.op(a, b)
+			if !slices.Equal(got.ToSlice(), tt.want) {
+				t.Errorf("SortedSet.%v() = %v, want %v", tt.name, got.ToSlice(), tt.want)
+			}
+			checkSortedNode(t, got.root)
+		})
+	}
+}
+
+func TestSortedSet_Compare(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     *SortedSet[int]
+		t     *SortedSet[int]
+		want  bool
+		want1 bool
+		want2 bool
+	}{
+		{"EmptySets", sortedSet(), sortedSet(), true, true, true},
+		{"EqualSets", sortedSet(1, 2), sortedSet(2, 1), true, true, true},
+		{"Subset", sortedSet(1), sortedSet(1, 2), false, true, false},
+		{"Superset", sortedSet(1, 2), sortedSet(2), false, false, true},
+		{"DifferentSets", sortedSet(1, 3), sortedSet(1, 2), false, false, false},
+		{"InnerSubset", sortedSet(2, 4), sortedSet(1, 2, 3, 4, 5), false, true, false},
+		{"GreaterElement", sortedSet(2, 6), sortedSet(1, 2, 3, 4, 5), false, false, false},
+		{"LessElement", sortedSet(0, 2), sortedSet(1, 2, 3, 4, 5), false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Equal(tt.t); got != tt.want {
+				t.Errorf("SortedSet.Equal() = %v, want %v", got, tt.want)
+			}
+			if got := tt.s.Subset(tt.t); got != tt.want1 {
+				t.Errorf("SortedSet.Subset() = %v, want %v", got, tt.want1)
+			}
+			if got := tt.s.Superset(tt.t); got != tt.want2 {
+				t.Errorf("SortedSet.Superset() = %v, want %v", got, tt.want2)
+			}
+		})
+	}
+}
+
+func TestSortedSet_SubsetAllocs(t *testing.T) {
+	s, u := sortedSet(), sortedSet()
+	for i := range 10000 {
+		u.Add(i)
+		if i%3 == 0 {
+			s.Add(i)
+		}
+	}
+
+	got := testing.AllocsPerRun(10, func() {
+		if !s.Subset(u) {
+			t.Fatalf("SortedSet.Subset() = %v, want %v", false, true)
+		}
+	})
+	if got != 0 {
+		t.Errorf("SortedSet.Subset() allocates %v times, want %v", got, 0)
+	}
+}
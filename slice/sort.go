@@ -1,5 +1,5 @@
 // Package slice implements various operations with slices.
-// It provides sort and search algorithms.
+// It provides sort, search and sliding window algorithms.
 package slice
 
 import (
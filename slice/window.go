@@ -0,0 +1,39 @@
+package slice
+
+import "github.com/qsoulior/misc/queue"
+
+// SlidingWindowMin returns minimum of each window of k consecutive elements with complexity O(n).
+// The i-th element of result is minimum of s[i:i+k].
+// It returns nil if k is not positive or greater than length of slice.
+// cmp should return a negative number if a precedes b, a positive number if a follows b,
+// and 0 if a and b are equal.
+func SlidingWindowMin[S ~[]E, E any](s S, k int, cmp func(a E, b E) int) S {
+	if k <= 0 || k > len(s) {
+		return nil
+	}
+
+	window := queue.NewMonotonicDeque(cmp)
+	result := make(S, 0, len(s)-k+1)
+	for i, v := range s {
+		window.PushBack(v)
+		if i < k-1 {
+			continue
+		}
+
+		// Front of window is the minimum, since greater elements are removed on push.
+		front, _ := window.Front()
+		result = append(result, front)
+		window.Evict(s[i-k+1])
+	}
+
+	return result
+}
+
+// SlidingWindowMax returns maximum of each window of k consecutive elements with complexity O(n).
+// The i-th element of result is maximum of s[i:i+k].
+// It returns nil if k is not positive or greater than length of slice.
+// cmp should return a negative number if a precedes b, a positive number if a follows b,
+// and 0 if a and b are equal.
+func SlidingWindowMax[S ~[]E, E any](s S, k int, cmp func(a E, b E) int) S {
+	return SlidingWindowMin(s, k, func(a, b E) int { return cmp(b, a) })
+}
@@ -0,0 +1,67 @@
+package slice
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+type WindowFunc func(s []int, k int, cmp func(a, b int) int) []int
+
+func testSlidingWindow(t *testing.T, fn WindowFunc, naive func(s []int) int, want []int) {
+	type args struct {
+		s []int
+		k int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{"NilSlice", args{nil, 1}, nil},
+		{"ZeroWindow", args{[]int{1, 2}, 0}, nil},
+		{"LargeWindow", args{[]int{1, 2}, 3}, nil},
+		{"SingleWindow", args{[]int{2, 3, 1}, 3}, []int{naive([]int{2, 3, 1})}},
+		{"SimpleSlice", args{[]int{1, 3, -1, -3, 5, 3, 6, 7}, 3}, want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fn(tt.args.s, tt.args.k, cmp); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Compare with naive implementation on random slices with duplicates.
+	for range 100 {
+		s := make([]int, rand.Intn(50)+1)
+		for i := range s {
+			s[i] = rand.Intn(10)
+		}
+		k := rand.Intn(len(s)) + 1
+
+		want := make([]int, 0, len(s)-k+1)
+		for i := 0; i+k <= len(s); i++ {
+			want = append(want, naive(s[i:i+k]))
+		}
+		if got := fn(s, k, cmp); !reflect.DeepEqual(got, want) {
+			t.Fatalf("s = %v, k = %v: got %v, want %v", s, k, got, want)
+		}
+	}
+}
+
+func TestSlidingWindowMin(t *testing.T) {
+	testSlidingWindow(t, SlidingWindowMin, slices.Min, []int{-1, -3, -3, -3, 3, 3})
+}
+
+func TestSlidingWindowMax(t *testing.T) {
+	testSlidingWindow(t, SlidingWindowMax, slices.Max, []int{3, 3, 5, 5, 6, 7})
+}
+
+func BenchmarkSlidingWindowMax(b *testing.B) {
+	s := rand.Perm(1e5)
+	for range b.N {
+		SlidingWindowMax(s, 100, cmp)
+	}
+}